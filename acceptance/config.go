@@ -12,13 +12,21 @@ var (
 	//go:embed keys/org-level-admin-sa.json
 	orgLevelAdminSAJSON []byte
 
+	//go:embed keys/system-api-sa.pem
+	systemAPIKey []byte
+
 	//go:embed config.json
 	configJson []byte
 )
 
+// systemAPIUser is configured in zitadel.yaml
+const systemAPIUser = "system-api-sa"
+
 type Config struct {
 	OrgLevel      IsolatedInstance
 	InstanceLevel IsolatedInstance
+	SystemAPIUser string
+	SystemAPIKey  []byte
 }
 
 type IsolatedInstance struct {
@@ -30,6 +38,8 @@ func GetConfig() Config {
 	val := Config{
 		OrgLevel:      IsolatedInstance{AdminSAJSON: orgLevelAdminSAJSON},
 		InstanceLevel: IsolatedInstance{AdminSAJSON: instanceLevelAdminSAJSON},
+		SystemAPIUser: systemAPIUser,
+		SystemAPIKey:  systemAPIKey,
 	}
 	if err := json.Unmarshal(configJson, &val); err != nil {
 		panic(err)
@@ -0,0 +1,5 @@
+data "zitadel_default_language" "default" {}
+
+output "default_language" {
+  value = data.zitadel_default_language.default
+}
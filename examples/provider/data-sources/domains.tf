@@ -0,0 +1,8 @@
+data "zitadel_domains" "default" {
+  org_id      = data.zitadel_org.default.id
+  is_verified = true
+}
+
+output "verified_domains" {
+  value = data.zitadel_domains.default.domains
+}
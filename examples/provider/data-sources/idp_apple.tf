@@ -0,0 +1,3 @@
+data "zitadel_idp_apple" "default" {
+  id = "123456789012345678"
+}
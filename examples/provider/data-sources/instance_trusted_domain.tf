@@ -0,0 +1,7 @@
+data "zitadel_instance_trusted_domain" "default" {
+  domain = "login.example.com"
+}
+
+output "instance_trusted_domain" {
+  value = data.zitadel_instance_trusted_domain.default
+}
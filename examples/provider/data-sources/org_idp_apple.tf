@@ -0,0 +1,4 @@
+data "zitadel_org_idp_apple" "default" {
+  org_id = data.zitadel_org.default.id
+  id     = "123456789012345678"
+}
@@ -0,0 +1,5 @@
+data "zitadel_sms_providers" "default" {}
+
+output "active_sms_provider_id" {
+  value = data.zitadel_sms_providers.default.active_provider_id
+}
@@ -1,2 +1,4 @@
 # The resource can be imported using the ID format `<id:project_id[:org_id][:client_id][:client_secret]>`, e.g.
 terraform import zitadel_application_oidc.imported '123456789012345678:123456789012345678:123456789012345678:123456789012345678@zitadel:JuaDFFeOak5DGE655KCYPSAclSkbMVEJXXuX1lEMBT14eLMSs0A0qhafKX5SA2Df'
+# or by names using the path format `org/<name-or-id>/project/<name-or-id>/app/<name-or-id>`, e.g.
+terraform import zitadel_application_oidc.imported 'org/my-org/project/my-project/app/my-app'
@@ -1,2 +1,4 @@
 # The resource can be imported using the ID format `<id:project_id[:org_id]>`, e.g.
 terraform import zitadel_application_saml.imported '123456789012345678:123456789012345678:123456789012345678'
+# or by names using the path format `org/<name-or-id>/project/<name-or-id>/app/<name-or-id>`, e.g.
+terraform import zitadel_application_saml.imported 'org/my-org/project/my-project/app/my-app'
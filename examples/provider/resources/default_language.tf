@@ -0,0 +1,5 @@
+resource "zitadel_default_language" "default" {
+  language                         = "en"
+  allowed_languages                = ["de", "en", "it"]
+  disallow_public_org_registration = false
+}
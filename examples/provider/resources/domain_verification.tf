@@ -0,0 +1,12 @@
+resource "zitadel_domain" "default" {
+  org_id = data.zitadel_org.default.id
+  name   = "verify.default.127.0.0.1.sslip.io"
+}
+
+resource "zitadel_domain_verification" "default" {
+  org_id          = data.zitadel_org.default.id
+  domain          = zitadel_domain.default.name
+  validation_type = "DOMAIN_VALIDATION_TYPE_DNS"
+  # set to true after the TXT record dns_record_name with the value dns_record_value is published
+  validate = false
+}
@@ -0,0 +1,5 @@
+resource "zitadel_email_provider_http" "default" {
+  endpoint    = "https://relay.example.com/email"
+  description = "internal messaging service"
+  set_active  = true
+}
@@ -1,2 +1,4 @@
 # The resource can be imported using the ID format `id[:org_id][:initial_password]>`, e.g.
 terraform import zitadel_human_user.imported '123456789012345678:123456789012345678:Password1!'
+# or by names using the path format `org/<name-or-id>/user/<username-or-id>`, e.g.
+terraform import zitadel_human_user.imported 'org/my-org/user/jane.doe'
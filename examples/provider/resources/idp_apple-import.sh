@@ -0,0 +1,4 @@
+# The resource can be imported using the ID format `<id[:private_key]>`, e.g.
+terraform import zitadel_idp_apple.imported "123456789012345678:$(cat AuthKey_GHIJKL5678.p8)"
+# or by names using the path format `idp/<name-or-id>`, e.g.
+terraform import zitadel_idp_apple.imported 'idp/Apple'
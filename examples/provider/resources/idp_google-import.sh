@@ -1,2 +1,4 @@
 # The resource can be imported using the ID format `<id[:client_secret]>`, e.g.
 terraform import zitadel_idp_google.imported '123456789012345678:G1234567890123'
+# or by names using the path format `idp/<name-or-id>`, e.g.
+terraform import zitadel_idp_google.imported 'idp/Google'
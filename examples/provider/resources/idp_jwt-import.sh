@@ -0,0 +1,4 @@
+# The resource can be imported using the ID format `<id>`, e.g.
+terraform import zitadel_idp_jwt.imported '123456789012345678'
+# or by names using the path format `idp/<name-or-id>`, e.g.
+terraform import zitadel_idp_jwt.imported 'idp/jwtidp'
@@ -0,0 +1,12 @@
+resource "zitadel_idp_jwt" "default" {
+  name                = "jwtidp"
+  issuer              = "https://google.com"
+  jwt_endpoint        = "https://jwtendpoint.com/jwt"
+  keys_endpoint       = "https://jwtendpoint.com/keys"
+  header_name         = "x-auth-token"
+  is_linking_allowed  = false
+  is_creation_allowed = true
+  is_auto_creation    = false
+  is_auto_update      = true
+  auto_linking        = "AUTO_LINKING_OPTION_USERNAME"
+}
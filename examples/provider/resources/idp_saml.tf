@@ -2,6 +2,7 @@ resource "zitadel_idp_saml" "default" {
   name                = "LDAP"
   binding             = "SAML_BINDING_POST"
   with_signed_request = true
+  name_id_format      = "SAML_NAME_ID_FORMAT_EMAIL_ADDRESS"
   is_linking_allowed  = false
   is_creation_allowed = true
   is_auto_creation    = false
@@ -0,0 +1,3 @@
+resource "zitadel_instance_domain" "default" {
+  domain = "login.example.com"
+}
@@ -0,0 +1,6 @@
+resource "zitadel_instance_features" "default" {
+  login_default_org    = true
+  user_schema          = false
+  oidc_token_exchange  = false
+  improved_performance = ["IMPROVED_PERFORMANCE_ORG_BY_ID"]
+}
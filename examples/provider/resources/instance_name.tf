@@ -0,0 +1,3 @@
+resource "zitadel_instance_name" "default" {
+  name = "my-instance"
+}
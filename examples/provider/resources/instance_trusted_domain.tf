@@ -0,0 +1,3 @@
+resource "zitadel_instance_trusted_domain" "default" {
+  domain = "login.example.com"
+}
@@ -0,0 +1,6 @@
+resource "zitadel_label_policy_activation" "default" {
+  org_id = data.zitadel_org.default.id
+  triggers = {
+    primary_color = "#5469d4"
+  }
+}
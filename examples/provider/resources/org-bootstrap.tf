@@ -0,0 +1,20 @@
+resource "zitadel_org" "tenant" {
+  name   = "tenant"
+  org_id = "tenant-production"
+
+  admins {
+    user_id = data.zitadel_human_user.default.id
+  }
+
+  admins {
+    roles = ["ORG_OWNER"]
+    human {
+      user_name         = "tenant-admin"
+      first_name        = "Tenant"
+      last_name         = "Admin"
+      email             = "admin@tenant.example.com"
+      is_email_verified = true
+      initial_password  = "Password1!"
+    }
+  }
+}
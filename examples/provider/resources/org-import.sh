@@ -1,2 +1,4 @@
 # The resource can be imported using the ID format `<id>`, e.g.
 terraform import zitadel_org.imported '123456789012345678'
+# or by names using the path format `org/<name-or-id>`, e.g.
+terraform import zitadel_org.imported 'org/my-org'
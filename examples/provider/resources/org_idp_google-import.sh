@@ -1,2 +1,4 @@
 # The resource can be imported using the ID format `<id[:org_id][:client_secret]>`, e.g.
 terraform import zitadel_org_idp_google.imported '123456789012345678:123456789012345678:G1234567890123'
+# or by names using the path format `org/<name-or-id>/idp/<name-or-id>`, e.g.
+terraform import zitadel_org_idp_google.imported 'org/my-org/idp/Google'
@@ -0,0 +1,4 @@
+resource "zitadel_org_primary_domain" "default" {
+  org_id = data.zitadel_org.default.id
+  domain = "zitadel.default.127.0.0.1.sslip.io"
+}
@@ -1,2 +1,4 @@
 # The resource can be imported using the ID format `<id[:org_id]>`, e.g.
 terraform import zitadel_project.imported '123456789012345678:123456789012345678'
+# or by names using the path format `org/<name-or-id>/project/<name-or-id>`, e.g.
+terraform import zitadel_project.imported 'org/my-org/project/my-project'
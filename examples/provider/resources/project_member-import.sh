@@ -1,2 +1,4 @@
 # The resource can be imported using the ID format `<project_id:user_id[:org_id]>`, e.g.
 terraform import zitadel_project_member.imported '123456789012345678:123456789012345678:123456789012345678'
+# or by names using the path format `org/<name-or-id>/project/<name-or-id>/user/<username-or-id>`, e.g.
+terraform import zitadel_project_member.imported 'org/my-org/project/my-project/user/jane.doe'
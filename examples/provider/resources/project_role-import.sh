@@ -1,2 +1,4 @@
 # The resource can be imported using the ID format `<project_id:role_key[:org_id]>`, e.g.
 terraform import zitadel_project_role.imported '123456789012345678:my-role-key:123456789012345678'
+# or by names using the path format `org/<name-or-id>/project/<name-or-id>/role/<role_key>`, e.g.
+terraform import zitadel_project_role.imported 'org/my-org/project/my-project/role/my-role-key'
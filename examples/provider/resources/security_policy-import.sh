@@ -0,0 +1,2 @@
+# The resource can be imported using the ID format `<>`, e.g.
+terraform import zitadel_security_policy.imported ''
@@ -0,0 +1,5 @@
+resource "zitadel_security_policy" "default" {
+  enable_iframe_embedding = true
+  allowed_origins         = ["https://example.com"]
+  enable_impersonation    = false
+}
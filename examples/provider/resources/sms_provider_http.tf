@@ -0,0 +1,5 @@
+resource "zitadel_sms_provider_http" "default" {
+  endpoint    = "https://relay.example.com/sms"
+  description = "internal messaging service"
+  set_active  = true
+}
@@ -6,4 +6,5 @@ resource "zitadel_smtp_config" "default" {
   user             = "user"
   password         = "secret_password"
   reply_to_address = "replyto@example.com"
+  description      = "default smtp config"
 }
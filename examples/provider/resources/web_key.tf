@@ -0,0 +1,9 @@
+resource "zitadel_web_key" "default" {
+  type       = "RSA"
+  rsa_bits   = "RSA_BITS_2048"
+  rsa_hasher = "RSA_HASHER_SHA256"
+  active     = false
+  keepers = {
+    rotation = "2024-01"
+  }
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/export"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
+)
+
+const exportCommand = "export"
+
+// runExport writes .tf files with import blocks for the resources of a ZITADEL instance
+func runExport(ctx context.Context, args []string) error {
+	flags := flag.NewFlagSet(exportCommand, flag.ExitOnError)
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: %s %s [flags]\n\nWrites .tf files with import blocks for all resources of a ZITADEL instance.\n\n", os.Args[0], exportCommand)
+		flags.PrintDefaults()
+	}
+	domain := flags.String("domain", "", "domain of the ZITADEL instance")
+	insecure := flags.Bool("insecure", false, "use http instead of https")
+	port := flags.String("port", "", "port of the ZITADEL instance")
+	jwtProfileFile := flags.String("jwt-profile-file", "", "path to the JSON key of a service user with IAM_OWNER permissions")
+	jwtProfileJSON := flags.String("jwt-profile-json", os.Getenv("ZITADEL_JWT_PROFILE_JSON"), "JSON key of a service user with IAM_OWNER permissions, defaults to the ZITADEL_JWT_PROFILE_JSON environment variable")
+	out := flags.String("out", ".", "directory to write the .tf files to")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *domain == "" {
+		flags.Usage()
+		return fmt.Errorf("-domain is required")
+	}
+
+	clientinfo, err := helper.GetClientInfo(ctx, *insecure, *domain, "", *jwtProfileFile, *jwtProfileJSON, *port, "", "")
+	if err != nil {
+		return err
+	}
+	exporter, err := export.NewExporter(ctx, clientinfo)
+	if err != nil {
+		return err
+	}
+	files, err := exporter.Export(ctx)
+	if err != nil {
+		return err
+	}
+	if err := export.WriteFiles(*out, files); err != nil {
+		return err
+	}
+	for _, file := range files {
+		fmt.Printf("wrote %d import blocks to %s\n", len(file.Imports), file.Name)
+	}
+	return nil
+}
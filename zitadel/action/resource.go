@@ -31,9 +31,11 @@ func GetResource() *schema.Resource {
 				Description: "",
 			},
 			timeoutVar: {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "after which time the action will be terminated if not finished",
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "after which time the action will be terminated if not finished",
+				ValidateDiagFunc: helper.DurationValidation,
+				DiffSuppressFunc: helper.SuppressEquivalentDurations,
 			},
 			allowedToFailVar: {
 				Type:        schema.TypeBool,
@@ -1,12 +1,13 @@
 package application_api
 
 const (
-	AppIDVar          = "app_id"
-	appIDsVar         = "app_ids"
-	ProjectIDVar      = "project_id"
-	NameVar           = "name"
-	nameMethodVar     = "name_method"
-	authMethodTypeVar = "auth_method_type"
-	ClientIDVar       = "client_id"
-	ClientSecretVar   = "client_secret"
+	AppIDVar            = "app_id"
+	appIDsVar           = "app_ids"
+	ProjectIDVar        = "project_id"
+	NameVar             = "name"
+	nameMethodVar       = "name_method"
+	authMethodTypeVar   = "auth_method_type"
+	ClientIDVar         = "client_id"
+	ClientSecretVar     = "client_secret"
+	rotationTriggersVar = "rotation_triggers"
 )
@@ -71,6 +71,19 @@ func update(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Dia
 			return diag.Errorf("failed to update applicationAPI: %v", err)
 		}
 	}
+
+	if d.HasChange(rotationTriggersVar) {
+		resp, err := client.RegenerateAPIClientSecret(helper.CtxWithOrgID(ctx, d), &management.RegenerateAPIClientSecretRequest{
+			ProjectId: projectID,
+			AppId:     d.Id(),
+		})
+		if err != nil {
+			return diag.Errorf("failed to regenerate client secret of applicationAPI: %v", err)
+		}
+		if err := d.Set(ClientSecretVar, resp.GetClientSecret()); err != nil {
+			return diag.Errorf("failed to set %s of applicationAPI: %v", ClientSecretVar, err)
+		}
+	}
 	return nil
 }
 
@@ -1,6 +1,8 @@
 package application_api
 
 import (
+	"context"
+
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -10,7 +12,7 @@ import (
 )
 
 func GetResource() *schema.Resource {
-	return &schema.Resource{
+	return helper.WithDeletionProtection(&schema.Resource{
 		Description: "Resource representing an API application belonging to a project, with all configuration possibilities.",
 		Schema: map[string]*schema.Schema{
 			helper.OrgIDVar: helper.OrgIDResourceField,
@@ -46,16 +48,30 @@ func GetResource() *schema.Resource {
 				Description: "generated secret for this config",
 				Sensitive:   true,
 			},
+			rotationTriggersVar: {
+				Type: schema.TypeMap,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Optional:    true,
+				Description: "Arbitrary map of values that, when changed, regenerates the client secret without recreating the application. Only applicable for the auth method type API_AUTH_METHOD_TYPE_BASIC.",
+			},
 		},
 		DeleteContext: delete,
 		CreateContext: create,
 		UpdateContext: update,
 		ReadContext:   read,
+		CustomizeDiff: func(ctx context.Context, diff *schema.ResourceDiff, m interface{}) error {
+			if diff.Id() != "" && diff.HasChange(rotationTriggersVar) {
+				return diff.SetNewComputed(ClientSecretVar)
+			}
+			return nil
+		},
 		Importer: helper.ImportWithIDAndOptionalOrg(
 			AppIDVar,
 			helper.NewImportAttribute(ProjectIDVar, helper.ConvertID, false),
 			helper.NewImportAttribute(ClientIDVar, helper.ConvertNonEmpty, true),
 			helper.NewImportAttribute(ClientSecretVar, helper.ConvertNonEmpty, true),
 		),
-	}
+	}, false)
 }
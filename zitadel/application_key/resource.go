@@ -10,7 +10,7 @@ import (
 )
 
 func GetResource() *schema.Resource {
-	return &schema.Resource{
+	return helper.WithDeletionProtection(&schema.Resource{
 		Description: "Resource representing a app key",
 		Schema: map[string]*schema.Schema{
 			helper.OrgIDVar: helper.OrgIDResourceField,
@@ -57,5 +57,5 @@ func GetResource() *schema.Resource {
 			helper.NewImportAttribute(AppIDVar, helper.ConvertID, false),
 			helper.NewImportAttribute(KeyDetailsVar, helper.ConvertJSON, true),
 		),
-	}
+	}, false)
 }
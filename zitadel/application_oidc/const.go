@@ -1,5 +1,7 @@
 package application_oidc
 
+import "github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
+
 const (
 	AppIDVar                    = "app_id"
 	appIDsVar                   = "app_ids"
@@ -23,4 +25,38 @@ const (
 	ClientIDVar                 = "client_id"
 	ClientSecretVar             = "client_secret"
 	skipNativeAppSuccessPageVar = "skip_native_app_success_page"
+	backChannelLogoutURIVar     = "back_channel_logout_uri"
+	loginVersionVar             = "login_version"
+	loginBaseURIVar             = "login_base_uri"
+	rotationTriggersVar         = "rotation_triggers"
+	noneCompliantVar            = "none_compliant"
+	complianceProblemsVar       = "compliance_problems"
+	issuerVar                   = "issuer"
+	authorizationEndpointVar    = "authorization_endpoint"
+	tokenEndpointVar            = "token_endpoint"
+	endSessionEndpointVar       = "end_session_endpoint"
+	jwksURIVar                  = "jwks_uri"
+)
+
+// paths of the OIDC endpoints relative to the instance issuer, as published in its discovery document
+const (
+	authorizationEndpointPath = "/oauth/v2/authorize"
+	tokenEndpointPath         = "/oauth/v2/token"
+	endSessionEndpointPath    = "/oidc/v1/end_session"
+	jwksURIPath               = "/oauth/v2/keys"
+)
+
+const (
+	loginVersionUnspecified = "LOGIN_VERSION_UNSPECIFIED"
+	loginVersion1           = "LOGIN_VERSION_1"
+	loginVersion2           = "LOGIN_VERSION_2"
+)
+
+var (
+	loginVersionName = map[int32]string{
+		0: loginVersionUnspecified,
+		1: loginVersion1,
+		2: loginVersion2,
+	}
+	loginVersionValue = helper.EnumValueMap(loginVersionName)
 )
@@ -125,6 +125,59 @@ func GetDatasource() *schema.Resource {
 				Computed:    true,
 				Description: "Skip the successful login page on native apps and directly redirect the user to the callback.",
 			},
+			backChannelLogoutURIVar: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "URI to which ZITADEL sends back-channel logout requests, when a session of the user ends",
+			},
+			loginVersionVar: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Login UI version used by the application",
+			},
+			loginBaseURIVar: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Base URI of the login UI",
+			},
+			noneCompliantVar: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Indicates whether the configuration does not comply with the OIDC specification",
+			},
+			complianceProblemsVar: {
+				Type: schema.TypeList,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Computed:    true,
+				Description: "Keys of the problems ZITADEL found regarding the compliance with the OIDC specification",
+			},
+			issuerVar: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Issuer of the tokens for the application",
+			},
+			authorizationEndpointVar: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "OIDC authorization endpoint",
+			},
+			tokenEndpointVar: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "OIDC token endpoint",
+			},
+			endSessionEndpointVar: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "OIDC end session endpoint",
+			},
+			jwksURIVar: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "URI of the JSON web key set to verify the tokens",
+			},
 		},
 		ReadContext: read,
 	}
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/app"
 	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/management"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/message"
 	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/object"
 	"google.golang.org/protobuf/types/known/durationpb"
 
@@ -51,6 +52,14 @@ func update(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Dia
 		return diag.FromErr(err)
 	}
 
+	var diags diag.Diagnostics
+	if d.HasChanges(responseTypesVar, grantTypesVar, appTypeVar, authMethodTypeVar, redirectURIsVar, devModeVar) {
+		diags = newOIDCConfig(d.Get).validate()
+		if diags.HasError() {
+			return diags
+		}
+	}
+
 	projectID := d.Get(ProjectIDVar).(string)
 
 	if d.HasChange(NameVar) {
@@ -79,6 +88,9 @@ func update(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Dia
 		clockSkewVar,
 		additionalOriginsVar,
 		skipNativeAppSuccessPageVar,
+		backChannelLogoutURIVar,
+		loginVersionVar,
+		loginBaseURIVar,
 	) {
 		respTypes := make([]app.OIDCResponseType, 0)
 		for _, respType := range d.Get(responseTypesVar).([]interface{}) {
@@ -110,12 +122,41 @@ func update(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Dia
 			AdditionalOrigins:        interfaceToStringSlice(d.Get(additionalOriginsVar)),
 			ClockSkew:                durationpb.New(dur),
 			SkipNativeAppSuccessPage: d.Get(skipNativeAppSuccessPageVar).(bool),
+			BackChannelLogoutUri:     d.Get(backChannelLogoutURIVar).(string),
+			LoginVersion:             loginVersionToProto(d.Get(loginVersionVar).(string), d.Get(loginBaseURIVar).(string)),
 		})
 		if err != nil {
 			return diag.Errorf("failed to update applicationOIDC: %v", err)
 		}
+		resp, err := client.GetAppByID(helper.CtxWithOrgID(ctx, d), &management.GetAppByIDRequest{ProjectId: projectID, AppId: d.Id()})
+		if err != nil {
+			return diag.Errorf("failed to get applicationOIDC: %v", err)
+		}
+		oidc := resp.GetApp().GetOidcConfig()
+		set := map[string]interface{}{
+			noneCompliantVar:      oidc.GetNoneCompliant(),
+			complianceProblemsVar: complianceProblemKeys(oidc.GetComplianceProblems()),
+		}
+		for k, v := range set {
+			if err := d.Set(k, v); err != nil {
+				return diag.Errorf("failed to set %s of applicationOIDC: %v", k, err)
+			}
+		}
 	}
-	return nil
+
+	if d.HasChange(rotationTriggersVar) {
+		resp, err := client.RegenerateOIDCClientSecret(helper.CtxWithOrgID(ctx, d), &management.RegenerateOIDCClientSecretRequest{
+			ProjectId: projectID,
+			AppId:     d.Id(),
+		})
+		if err != nil {
+			return diag.Errorf("failed to regenerate client secret of applicationOIDC: %v", err)
+		}
+		if err := d.Set(ClientSecretVar, resp.GetClientSecret()); err != nil {
+			return diag.Errorf("failed to set %s of applicationOIDC: %v", ClientSecretVar, err)
+		}
+	}
+	return diags
 }
 
 func create(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -131,6 +172,11 @@ func create(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Dia
 		return diag.FromErr(err)
 	}
 
+	diags := newOIDCConfig(d.Get).validate()
+	if diags.HasError() {
+		return diags
+	}
+
 	respTypes := make([]app.OIDCResponseType, 0)
 	for _, respType := range d.Get(responseTypesVar).([]interface{}) {
 		respTypes = append(respTypes, app.OIDCResponseType(app.OIDCResponseType_value[respType.(string)]))
@@ -163,11 +209,18 @@ func create(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Dia
 		AdditionalOrigins:        interfaceToStringSlice(d.Get(additionalOriginsVar)),
 		Version:                  app.OIDCVersion(app.OIDCVersion_value[d.Get(versionVar).(string)]),
 		SkipNativeAppSuccessPage: d.Get(skipNativeAppSuccessPageVar).(bool),
+		BackChannelLogoutUri:     d.Get(backChannelLogoutURIVar).(string),
+		LoginVersion:             loginVersionToProto(d.Get(loginVersionVar).(string), d.Get(loginBaseURIVar).(string)),
 	})
 
 	set := map[string]interface{}{
-		ClientIDVar:     resp.GetClientId(),
-		ClientSecretVar: resp.GetClientSecret(),
+		ClientIDVar:           resp.GetClientId(),
+		ClientSecretVar:       resp.GetClientSecret(),
+		noneCompliantVar:      resp.GetNoneCompliant(),
+		complianceProblemsVar: complianceProblemKeys(resp.GetComplianceProblems()),
+	}
+	for k, v := range endpoints(clientinfo.Issuer) {
+		set[k] = v
 	}
 	for k, v := range set {
 		if err := d.Set(k, v); err != nil {
@@ -179,7 +232,7 @@ func create(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Dia
 		return diag.Errorf("failed to create applicationOIDC: %v", err)
 	}
 	d.SetId(resp.GetAppId())
-	return nil
+	return diags
 }
 
 func read(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -218,6 +271,7 @@ func read(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagn
 	if clockSkew == "" {
 		clockSkew = "0s"
 	}
+	loginVersion, loginBaseURI := loginVersionFromProto(oidc.GetLoginVersion())
 
 	set := map[string]interface{}{
 		helper.OrgIDVar:             oidcApp.GetDetails().GetResourceOwner(),
@@ -238,6 +292,14 @@ func read(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagn
 		additionalOriginsVar:        oidc.GetAdditionalOrigins(),
 		ClientIDVar:                 oidc.GetClientId(),
 		skipNativeAppSuccessPageVar: oidc.GetSkipNativeAppSuccessPage(),
+		backChannelLogoutURIVar:     oidc.GetBackChannelLogoutUri(),
+		loginVersionVar:             loginVersion,
+		loginBaseURIVar:             loginBaseURI,
+		noneCompliantVar:            oidc.GetNoneCompliant(),
+		complianceProblemsVar:       complianceProblemKeys(oidc.GetComplianceProblems()),
+	}
+	for k, v := range endpoints(clientinfo.Issuer) {
+		set[k] = v
 	}
 	for k, v := range set {
 		if err := d.Set(k, v); err != nil {
@@ -257,6 +319,50 @@ func interfaceToStringSlice(in interface{}) []string {
 	return ret
 }
 
+func loginVersionToProto(version, baseURI string) *app.LoginVersion {
+	switch version {
+	case loginVersion1:
+		return &app.LoginVersion{Version: &app.LoginVersion_LoginV1{LoginV1: &app.LoginV1{}}}
+	case loginVersion2:
+		loginV2 := &app.LoginV2{}
+		if baseURI != "" {
+			loginV2.BaseUri = &baseURI
+		}
+		return &app.LoginVersion{Version: &app.LoginVersion_LoginV2{LoginV2: loginV2}}
+	default:
+		return nil
+	}
+}
+
+func loginVersionFromProto(version *app.LoginVersion) (string, string) {
+	switch {
+	case version.GetLoginV1() != nil:
+		return loginVersion1, ""
+	case version.GetLoginV2() != nil:
+		return loginVersion2, version.GetLoginV2().GetBaseUri()
+	default:
+		return loginVersionUnspecified, ""
+	}
+}
+
+func complianceProblemKeys(problems []*message.LocalizedMessage) []string {
+	keys := make([]string, 0, len(problems))
+	for _, problem := range problems {
+		keys = append(keys, problem.GetKey())
+	}
+	return keys
+}
+
+func endpoints(issuer string) map[string]interface{} {
+	return map[string]interface{}{
+		issuerVar:                issuer,
+		authorizationEndpointVar: issuer + authorizationEndpointPath,
+		tokenEndpointVar:         issuer + tokenEndpointPath,
+		endSessionEndpointVar:    issuer + endSessionEndpointPath,
+		jwksURIVar:               issuer + jwksURIPath,
+	}
+}
+
 func list(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	tflog.Info(ctx, "started list")
 	name := d.Get(NameVar).(string)
@@ -1,8 +1,11 @@
 package application_oidc
 
 import (
+	"context"
+
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/app"
 
@@ -10,7 +13,7 @@ import (
 )
 
 func GetResource() *schema.Resource {
-	return &schema.Resource{
+	return helper.WithDeletionProtection(&schema.Resource{
 		Description: "Resource representing an OIDC application belonging to a project, with all configuration possibilities.",
 		Schema: map[string]*schema.Schema{
 			helper.OrgIDVar: helper.OrgIDResourceField,
@@ -28,34 +31,33 @@ func GetResource() *schema.Resource {
 			redirectURIsVar: {
 				Type: schema.TypeList,
 				Elem: &schema.Schema{
-					Type: schema.TypeString,
+					Type:             schema.TypeString,
+					ValidateDiagFunc: absoluteURIValidation,
 				},
 				Required:    true,
-				Description: "RedirectURIs",
+				Description: "RedirectURIs, http URIs are only allowed with dev_mode enabled or for native apps on localhost",
 			},
 			responseTypesVar: {
 				Type: schema.TypeList,
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
+					ValidateDiagFunc: func(value interface{}, path cty.Path) diag.Diagnostics {
+						return helper.EnumValueValidation(responseTypesVar, value, app.OIDCResponseType_value)
+					},
 				},
 				Required:    true,
 				Description: "Response type" + helper.DescriptionEnumValuesList(app.OIDCResponseType_name),
-				/* Not yet supported
-				ValidateDiagFunc: func(value interface{}, path cty.Path) diag.Diagnostics {
-					return enumValuesValidation(applicationAuthMethodTypeVar, value, app.OIDCResponseType_value)
-				},*/
 			},
 			grantTypesVar: {
 				Type: schema.TypeList,
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
+					ValidateDiagFunc: func(value interface{}, path cty.Path) diag.Diagnostics {
+						return helper.EnumValueValidation(grantTypesVar, value, app.OIDCGrantType_value)
+					},
 				},
 				Required:    true,
 				Description: "Grant types" + helper.DescriptionEnumValuesList(app.OIDCGrantType_name),
-				/* Not yet supported
-				ValidateDiagFunc: func(value interface{}, path cty.Path) diag.Diagnostics {
-					return enumValuesValidation(applicationGrantTypesVar, value, app.OIDCGrantType_value)
-				},*/
 			},
 			appTypeVar: {
 				Type:        schema.TypeString,
@@ -78,7 +80,8 @@ func GetResource() *schema.Resource {
 			postLogoutRedirectURIsVar: {
 				Type: schema.TypeList,
 				Elem: &schema.Schema{
-					Type: schema.TypeString,
+					Type:             schema.TypeString,
+					ValidateDiagFunc: absoluteURIValidation,
 				},
 				Optional:    true,
 				Description: "Post logout redirect URIs",
@@ -120,10 +123,12 @@ func GetResource() *schema.Resource {
 				Description: "Token userinfo assertion",
 			},
 			clockSkewVar: {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "Clockskew",
-				Default:     "0s",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "Clockskew",
+				Default:          "0s",
+				ValidateDiagFunc: helper.DurationValidation,
+				DiffSuppressFunc: helper.SuppressEquivalentDurations,
 			},
 			additionalOriginsVar: {
 				Type: schema.TypeList,
@@ -145,21 +150,104 @@ func GetResource() *schema.Resource {
 				Description: "generated secret for this config",
 				Sensitive:   true,
 			},
+			rotationTriggersVar: {
+				Type: schema.TypeMap,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Optional:    true,
+				Description: "Arbitrary map of values that, when changed, regenerates the client secret without recreating the application. Only applicable for the auth method types OIDC_AUTH_METHOD_TYPE_BASIC and OIDC_AUTH_METHOD_TYPE_POST.",
+			},
 			skipNativeAppSuccessPageVar: {
 				Type:        schema.TypeBool,
 				Optional:    true,
 				Description: "Skip the successful login page on native apps and directly redirect the user to the callback.",
 			},
+			backChannelLogoutURIVar: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "URI to which ZITADEL sends back-channel logout requests, when a session of the user ends",
+			},
+			loginVersionVar: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Login UI version used by the application" + helper.DescriptionEnumValuesList(loginVersionName) + ". If unspecified, the instance default is used.",
+				ValidateDiagFunc: func(value interface{}, path cty.Path) diag.Diagnostics {
+					return helper.EnumValueValidation(loginVersionVar, value, loginVersionValue)
+				},
+				Default: loginVersionUnspecified,
+			},
+			loginBaseURIVar: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Base URI of the login UI, only used with " + loginVersion2 + ". If unspecified, the default URI is used.",
+			},
+			noneCompliantVar: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Indicates whether the configuration does not comply with the OIDC specification",
+			},
+			complianceProblemsVar: {
+				Type: schema.TypeList,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Computed:    true,
+				Description: "Keys of the problems ZITADEL found regarding the compliance with the OIDC specification",
+			},
+			issuerVar: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Issuer of the tokens for the application",
+			},
+			authorizationEndpointVar: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "OIDC authorization endpoint",
+			},
+			tokenEndpointVar: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "OIDC token endpoint",
+			},
+			endSessionEndpointVar: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "OIDC end session endpoint",
+			},
+			jwksURIVar: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "URI of the JSON web key set to verify the tokens",
+			},
 		},
 		DeleteContext: delete,
 		CreateContext: create,
 		UpdateContext: update,
 		ReadContext:   read,
+		CustomizeDiff: customdiff.All(
+			customizeDiffCompliance,
+			func(ctx context.Context, diff *schema.ResourceDiff, m interface{}) error {
+				if diff.Id() != "" && diff.HasChange(rotationTriggersVar) {
+					return diff.SetNewComputed(ClientSecretVar)
+				}
+				return nil
+			},
+			func(ctx context.Context, diff *schema.ResourceDiff, m interface{}) error {
+				if diff.Id() == "" || !diff.HasChanges(responseTypesVar, grantTypesVar, appTypeVar, authMethodTypeVar, redirectURIsVar, postLogoutRedirectURIsVar, devModeVar) {
+					return nil
+				}
+				if err := diff.SetNewComputed(noneCompliantVar); err != nil {
+					return err
+				}
+				return diff.SetNewComputed(complianceProblemsVar)
+			},
+		),
 		Importer: helper.ImportWithIDAndOptionalOrg(
 			AppIDVar,
 			helper.NewImportAttribute(ProjectIDVar, helper.ConvertID, false),
 			helper.NewImportAttribute(ClientIDVar, helper.ConvertNonEmpty, true),
 			helper.NewImportAttribute(ClientSecretVar, helper.ConvertNonEmpty, true),
 		),
-	}
+	}, false)
 }
@@ -0,0 +1,177 @@
+package application_oidc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"slices"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/app"
+)
+
+// requiredGrantTypes maps the response types to the grant type ZITADEL requires for them
+var requiredGrantTypes = map[string]string{
+	app.OIDCResponseType_OIDC_RESPONSE_TYPE_CODE.String():           app.OIDCGrantType_OIDC_GRANT_TYPE_AUTHORIZATION_CODE.String(),
+	app.OIDCResponseType_OIDC_RESPONSE_TYPE_ID_TOKEN.String():       app.OIDCGrantType_OIDC_GRANT_TYPE_IMPLICIT.String(),
+	app.OIDCResponseType_OIDC_RESPONSE_TYPE_ID_TOKEN_TOKEN.String(): app.OIDCGrantType_OIDC_GRANT_TYPE_IMPLICIT.String(),
+}
+
+type oidcConfig struct {
+	responseTypes  []string
+	grantTypes     []string
+	appType        string
+	authMethodType string
+	redirectURIs   []string
+	devMode        bool
+}
+
+func newOIDCConfig(get func(string) interface{}) *oidcConfig {
+	return &oidcConfig{
+		responseTypes:  interfaceToStringSlice(get(responseTypesVar)),
+		grantTypes:     interfaceToStringSlice(get(grantTypesVar)),
+		appType:        get(appTypeVar).(string),
+		authMethodType: get(authMethodTypeVar).(string),
+		redirectURIs:   interfaceToStringSlice(get(redirectURIsVar)),
+		devMode:        get(devModeVar).(bool),
+	}
+}
+
+// validate mirrors the checks ZITADEL applies to OIDC applications.
+// Combinations ZITADEL rejects are returned as errors,
+// problems of ZITADEL's advisory compliance check are returned as warnings, as ZITADEL accepts these applications.
+func (c *oidcConfig) validate() diag.Diagnostics {
+	var diags diag.Diagnostics
+	for i, responseType := range c.responseTypes {
+		grantType, ok := requiredGrantTypes[responseType]
+		if ok && !slices.Contains(c.grantTypes, grantType) {
+			diags = append(diags, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       fmt.Sprintf("response type %s requires the grant type %s", responseType, grantType),
+				AttributePath: cty.GetAttrPath(responseTypesVar).IndexInt(i),
+			})
+		}
+	}
+	hasCode := slices.Contains(c.grantTypes, app.OIDCGrantType_OIDC_GRANT_TYPE_AUTHORIZATION_CODE.String())
+	hasImplicit := slices.Contains(c.grantTypes, app.OIDCGrantType_OIDC_GRANT_TYPE_IMPLICIT.String())
+	hasDeviceCode := slices.Contains(c.grantTypes, app.OIDCGrantType_OIDC_GRANT_TYPE_DEVICE_CODE.String())
+	if !hasCode {
+		if i := slices.Index(c.grantTypes, app.OIDCGrantType_OIDC_GRANT_TYPE_REFRESH_TOKEN.String()); i >= 0 {
+			diags = append(diags, complianceWarning(cty.GetAttrPath(grantTypesVar).IndexInt(i), "the refresh token grant type is only used together with the authorization code grant type"))
+		}
+	}
+	if len(c.redirectURIs) == 0 && (!hasDeviceCode || hasCode) {
+		diags = append(diags, complianceWarning(cty.GetAttrPath(redirectURIsVar), "no redirect URIs are configured"))
+	}
+	if !c.devMode {
+		diags = append(diags, c.redirectURIProblems(hasCode, hasImplicit)...)
+	}
+	isNative := c.appType == app.OIDCAppType_OIDC_APP_TYPE_NATIVE.String()
+	isUserAgent := c.appType == app.OIDCAppType_OIDC_APP_TYPE_USER_AGENT.String()
+	if (isNative || isUserAgent) && c.authMethodType != app.OIDCAuthMethodType_OIDC_AUTH_METHOD_TYPE_NONE.String() {
+		diags = append(diags, complianceWarning(cty.GetAttrPath(authMethodTypeVar), fmt.Sprintf("apps of type %s should use the auth method type %s", c.appType, app.OIDCAuthMethodType_OIDC_AUTH_METHOD_TYPE_NONE.String())))
+	}
+	return diags
+}
+
+// redirectURIProblems returns a warning for each redirect URI ZITADEL's compliance check reports a problem for
+func (c *oidcConfig) redirectURIProblems(hasCode, hasImplicit bool) diag.Diagnostics {
+	if !hasCode && !hasImplicit {
+		return nil
+	}
+	isNative := c.appType == app.OIDCAppType_OIDC_APP_TYPE_NATIVE.String()
+	isUserAgent := c.appType == app.OIDCAppType_OIDC_APP_TYPE_USER_AGENT.String()
+	var diags diag.Diagnostics
+	for i, uri := range c.redirectURIs {
+		parsed, err := url.Parse(uri)
+		if err != nil || parsed.Scheme == "https" {
+			continue
+		}
+		path := cty.GetAttrPath(redirectURIsVar).IndexInt(i)
+		if parsed.Scheme != "http" {
+			switch {
+			case hasImplicit && !hasCode:
+				diags = append(diags, complianceWarning(path, fmt.Sprintf(`custom scheme URI "%s" is not allowed for the implicit flow`, uri)))
+			case !isNative:
+				diags = append(diags, complianceWarning(path, fmt.Sprintf(`custom scheme URI "%s" is only allowed for native apps`, uri)))
+			}
+			continue
+		}
+		switch {
+		case isNative && !isLoopback(parsed.Hostname()):
+			diags = append(diags, complianceWarning(path, fmt.Sprintf(`http URI "%s" is only allowed on localhost for native apps`, uri)))
+		case isNative:
+		case hasImplicit && !hasCode:
+			diags = append(diags, complianceWarning(path, fmt.Sprintf(`http URI "%s" is not allowed for the implicit flow`, uri)))
+		case isUserAgent:
+			diags = append(diags, complianceWarning(path, fmt.Sprintf(`http URI "%s" is only allowed for web apps`, uri)))
+		}
+	}
+	return diags
+}
+
+func complianceWarning(path cty.Path, summary string) diag.Diagnostic {
+	return diag.Diagnostic{
+		Severity:      diag.Warning,
+		Summary:       summary,
+		Detail:        fmt.Sprintf("ZITADEL accepts the application, but reports it as not compliant with OIDC unless %s is enabled", devModeVar),
+		AttributePath: path,
+	}
+}
+
+func isLoopback(host string) bool {
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+// customizeDiffCompliance fails the plan for combinations ZITADEL rejects.
+// As a CustomizeDiff function can't return warnings or attribute paths,
+// create and update report the full diagnostics of validate.
+func customizeDiffCompliance(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	for _, key := range []string{responseTypesVar, grantTypesVar, appTypeVar, authMethodTypeVar, redirectURIsVar, devModeVar} {
+		if !diff.NewValueKnown(key) {
+			return nil
+		}
+	}
+	return complianceError(newOIDCConfig(diff.Get).validate())
+}
+
+// complianceError joins the errors of the diagnostics, each prefixed with the name of its attribute
+func complianceError(diags diag.Diagnostics) error {
+	var errs []error
+	for _, d := range diags {
+		if d.Severity != diag.Error {
+			continue
+		}
+		if len(d.AttributePath) > 0 {
+			if step, ok := d.AttributePath[0].(cty.GetAttrStep); ok {
+				errs = append(errs, fmt.Errorf("%s: %s", step.Name, d.Summary))
+				continue
+			}
+		}
+		errs = append(errs, errors.New(d.Summary))
+	}
+	return errors.Join(errs...)
+}
+
+func absoluteURIValidation(value interface{}, path cty.Path) diag.Diagnostics {
+	uri, ok := value.(string)
+	if !ok {
+		return diag.Errorf("value is no string")
+	}
+	parsed, err := url.Parse(uri)
+	if err != nil {
+		return diag.Diagnostics{{Severity: diag.Error, Summary: fmt.Sprintf(`invalid URI "%s": %v`, uri, err), AttributePath: path}}
+	}
+	if !parsed.IsAbs() {
+		return diag.Diagnostics{{Severity: diag.Error, Summary: fmt.Sprintf(`URI "%s" must be absolute and contain a scheme`, uri), AttributePath: path}}
+	}
+	return nil
+}
@@ -0,0 +1,217 @@
+package application_oidc
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+func TestOIDCConfigValidate(t *testing.T) {
+	validWeb := func() *oidcConfig {
+		return &oidcConfig{
+			responseTypes:  []string{"OIDC_RESPONSE_TYPE_CODE"},
+			grantTypes:     []string{"OIDC_GRANT_TYPE_AUTHORIZATION_CODE"},
+			appType:        "OIDC_APP_TYPE_WEB",
+			authMethodType: "OIDC_AUTH_METHOD_TYPE_BASIC",
+			redirectURIs:   []string{"https://localhost.com"},
+		}
+	}
+	type expectedDiagnostic struct {
+		severity diag.Severity
+		path     cty.Path
+		summary  string
+	}
+	tests := []struct {
+		name   string
+		config func() *oidcConfig
+		expect []expectedDiagnostic
+	}{{
+		name:   "web app with code flow works",
+		config: validWeb,
+	}, {
+		name: "implicit response type without implicit grant type fails",
+		config: func() *oidcConfig {
+			cfg := validWeb()
+			cfg.responseTypes = []string{"OIDC_RESPONSE_TYPE_CODE", "OIDC_RESPONSE_TYPE_ID_TOKEN"}
+			return cfg
+		},
+		expect: []expectedDiagnostic{{
+			severity: diag.Error,
+			path:     cty.GetAttrPath(responseTypesVar).IndexInt(1),
+			summary:  "response type OIDC_RESPONSE_TYPE_ID_TOKEN requires the grant type OIDC_GRANT_TYPE_IMPLICIT",
+		}},
+	}, {
+		name: "code flow without redirect uris warns",
+		config: func() *oidcConfig {
+			cfg := validWeb()
+			cfg.redirectURIs = nil
+			return cfg
+		},
+		expect: []expectedDiagnostic{{
+			severity: diag.Warning,
+			path:     cty.GetAttrPath(redirectURIsVar),
+			summary:  "no redirect URIs are configured",
+		}},
+	}, {
+		name: "device code only without redirect uris works",
+		config: func() *oidcConfig {
+			cfg := validWeb()
+			cfg.responseTypes = nil
+			cfg.grantTypes = []string{"OIDC_GRANT_TYPE_DEVICE_CODE"}
+			cfg.redirectURIs = nil
+			return cfg
+		},
+	}, {
+		name: "refresh token without authorization code warns",
+		config: func() *oidcConfig {
+			cfg := validWeb()
+			cfg.responseTypes = nil
+			cfg.grantTypes = []string{"OIDC_GRANT_TYPE_DEVICE_CODE", "OIDC_GRANT_TYPE_REFRESH_TOKEN"}
+			cfg.redirectURIs = nil
+			return cfg
+		},
+		expect: []expectedDiagnostic{{
+			severity: diag.Warning,
+			path:     cty.GetAttrPath(grantTypesVar).IndexInt(1),
+			summary:  "the refresh token grant type is only used together with the authorization code grant type",
+		}},
+	}, {
+		name: "http redirect uri for web app works",
+		config: func() *oidcConfig {
+			cfg := validWeb()
+			cfg.redirectURIs = []string{"https://localhost.com", "http://localhost.com"}
+			return cfg
+		},
+	}, {
+		name: "http redirect uri for user agent app warns",
+		config: func() *oidcConfig {
+			cfg := validWeb()
+			cfg.appType = "OIDC_APP_TYPE_USER_AGENT"
+			cfg.authMethodType = "OIDC_AUTH_METHOD_TYPE_NONE"
+			cfg.redirectURIs = []string{"https://localhost.com", "http://localhost.com"}
+			return cfg
+		},
+		expect: []expectedDiagnostic{{
+			severity: diag.Warning,
+			path:     cty.GetAttrPath(redirectURIsVar).IndexInt(1),
+			summary:  `http URI "http://localhost.com" is only allowed for web apps`,
+		}},
+	}, {
+		name: "http redirect uri for user agent app with dev mode works",
+		config: func() *oidcConfig {
+			cfg := validWeb()
+			cfg.appType = "OIDC_APP_TYPE_USER_AGENT"
+			cfg.authMethodType = "OIDC_AUTH_METHOD_TYPE_NONE"
+			cfg.redirectURIs = []string{"http://localhost.com"}
+			cfg.devMode = true
+			return cfg
+		},
+	}, {
+		name: "http redirect uri for implicit flow warns",
+		config: func() *oidcConfig {
+			cfg := validWeb()
+			cfg.responseTypes = []string{"OIDC_RESPONSE_TYPE_ID_TOKEN"}
+			cfg.grantTypes = []string{"OIDC_GRANT_TYPE_IMPLICIT"}
+			cfg.redirectURIs = []string{"http://localhost.com"}
+			return cfg
+		},
+		expect: []expectedDiagnostic{{
+			severity: diag.Warning,
+			path:     cty.GetAttrPath(redirectURIsVar).IndexInt(0),
+			summary:  `http URI "http://localhost.com" is not allowed for the implicit flow`,
+		}},
+	}, {
+		name: "custom scheme for user agent app with code and implicit flow warns",
+		config: func() *oidcConfig {
+			cfg := validWeb()
+			cfg.appType = "OIDC_APP_TYPE_USER_AGENT"
+			cfg.authMethodType = "OIDC_AUTH_METHOD_TYPE_NONE"
+			cfg.responseTypes = []string{"OIDC_RESPONSE_TYPE_CODE", "OIDC_RESPONSE_TYPE_ID_TOKEN"}
+			cfg.grantTypes = []string{"OIDC_GRANT_TYPE_AUTHORIZATION_CODE", "OIDC_GRANT_TYPE_IMPLICIT"}
+			cfg.redirectURIs = []string{"myapp://callback"}
+			return cfg
+		},
+		expect: []expectedDiagnostic{{
+			severity: diag.Warning,
+			path:     cty.GetAttrPath(redirectURIsVar).IndexInt(0),
+			summary:  `custom scheme URI "myapp://callback" is only allowed for native apps`,
+		}},
+	}, {
+		name: "native app with custom scheme and loopback works",
+		config: func() *oidcConfig {
+			cfg := validWeb()
+			cfg.appType = "OIDC_APP_TYPE_NATIVE"
+			cfg.authMethodType = "OIDC_AUTH_METHOD_TYPE_NONE"
+			cfg.redirectURIs = []string{"myapp://callback", "http://127.0.0.1:8080/callback", "http://localhost/callback"}
+			return cfg
+		},
+	}, {
+		name: "native app with http on other hosts warns",
+		config: func() *oidcConfig {
+			cfg := validWeb()
+			cfg.appType = "OIDC_APP_TYPE_NATIVE"
+			cfg.authMethodType = "OIDC_AUTH_METHOD_TYPE_NONE"
+			cfg.redirectURIs = []string{"myapp://callback", "http://example.com/callback"}
+			return cfg
+		},
+		expect: []expectedDiagnostic{{
+			severity: diag.Warning,
+			path:     cty.GetAttrPath(redirectURIsVar).IndexInt(1),
+			summary:  `http URI "http://example.com/callback" is only allowed on localhost for native apps`,
+		}},
+	}, {
+		name: "user agent app with basic auth warns",
+		config: func() *oidcConfig {
+			cfg := validWeb()
+			cfg.appType = "OIDC_APP_TYPE_USER_AGENT"
+			return cfg
+		},
+		expect: []expectedDiagnostic{{
+			severity: diag.Warning,
+			path:     cty.GetAttrPath(authMethodTypeVar),
+			summary:  "apps of type OIDC_APP_TYPE_USER_AGENT should use the auth method type OIDC_AUTH_METHOD_TYPE_NONE",
+		}},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := tt.config().validate()
+			if len(diags) != len(tt.expect) {
+				t.Fatalf("validate() expected %d diagnostics, got: %v", len(tt.expect), diags)
+			}
+			for i, expect := range tt.expect {
+				got := diags[i]
+				if got.Severity != expect.severity {
+					t.Errorf("validate() diagnostic %d severity = %v, want %v", i, got.Severity, expect.severity)
+				}
+				if !got.AttributePath.Equals(expect.path) {
+					t.Errorf("validate() diagnostic %d path = %#v, want %#v", i, got.AttributePath, expect.path)
+				}
+				if !strings.Contains(got.Summary, expect.summary) {
+					t.Errorf(`validate() diagnostic %d summary = "%s", want "%s"`, i, got.Summary, expect.summary)
+				}
+			}
+		})
+	}
+}
+
+func TestComplianceError(t *testing.T) {
+	diags := diag.Diagnostics{{
+		Severity:      diag.Error,
+		Summary:       "response type OIDC_RESPONSE_TYPE_ID_TOKEN requires the grant type OIDC_GRANT_TYPE_IMPLICIT",
+		AttributePath: cty.GetAttrPath(responseTypesVar).IndexInt(1),
+	}, complianceWarning(cty.GetAttrPath(redirectURIsVar), "no redirect URIs are configured"), {
+		Severity:      diag.Error,
+		Summary:       `URI "localhost" must be absolute and contain a scheme`,
+		AttributePath: cty.GetAttrPath(redirectURIsVar).IndexInt(0),
+	}}
+	expect := responseTypesVar + ": response type OIDC_RESPONSE_TYPE_ID_TOKEN requires the grant type OIDC_GRANT_TYPE_IMPLICIT\n" +
+		redirectURIsVar + `: URI "localhost" must be absolute and contain a scheme`
+	if err := complianceError(diags); err == nil || err.Error() != expect {
+		t.Errorf(`complianceError() = "%v", want "%s"`, err, expect)
+	}
+	if err := complianceError(diag.Diagnostics{complianceWarning(cty.GetAttrPath(redirectURIsVar), "no redirect URIs are configured")}); err != nil {
+		t.Errorf("complianceError() with warnings only = %v, want nil", err)
+	}
+}
@@ -7,4 +7,15 @@ const (
 	NameVar        = "name"
 	nameMethodVar  = "name_method"
 	MetadataXMLVar = "metadata_xml"
+	MetadataURLVar = "metadata_url"
+
+	serviceProviderVar          = "service_provider"
+	entityIDVar                 = "entity_id"
+	assertionConsumerServiceVar = "assertion_consumer_service"
+	locationVar                 = "location"
+	bindingVar                  = "binding"
+	singleLogoutURLVar          = "single_logout_url"
+	singleLogoutBindingVar      = "single_logout_binding"
+	certificateVar              = "certificate"
+	nameIDFormatVar             = "name_id_format"
 )
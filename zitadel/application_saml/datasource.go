@@ -34,6 +34,11 @@ func GetDatasource() *schema.Resource {
 				Computed:    true,
 				Description: "Metadata as XML file",
 			},
+			MetadataURLVar: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "URL from which ZITADEL fetches the metadata",
+			},
 		},
 		ReadContext: read,
 	}
@@ -61,14 +61,17 @@ func update(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Dia
 		}
 	}
 
-	if d.HasChanges(MetadataXMLVar) {
-		_, err = client.UpdateSAMLAppConfig(helper.CtxWithOrgID(ctx, d), &management.UpdateSAMLAppConfigRequest{
+	if d.HasChanges(MetadataXMLVar, MetadataURLVar) {
+		req := &management.UpdateSAMLAppConfigRequest{
 			ProjectId: projectID,
 			AppId:     d.Id(),
-			Metadata: &management.UpdateSAMLAppConfigRequest_MetadataXml{
-				MetadataXml: []byte(d.Get(MetadataXMLVar).(string)),
-			},
-		})
+		}
+		if metadataURL := d.Get(MetadataURLVar).(string); metadataURL != "" {
+			req.Metadata = &management.UpdateSAMLAppConfigRequest_MetadataUrl{MetadataUrl: metadataURL}
+		} else {
+			req.Metadata = &management.UpdateSAMLAppConfigRequest_MetadataXml{MetadataXml: []byte(d.Get(MetadataXMLVar).(string))}
+		}
+		_, err = client.UpdateSAMLAppConfig(helper.CtxWithOrgID(ctx, d), req)
 		if err != nil {
 			return diag.Errorf("failed to update applicationSAML: %v", err)
 		}
@@ -89,11 +92,16 @@ func create(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Dia
 		return diag.FromErr(err)
 	}
 
-	resp, err := client.AddSAMLApp(helper.CtxWithOrgID(ctx, d), &management.AddSAMLAppRequest{
+	req := &management.AddSAMLAppRequest{
 		ProjectId: d.Get(ProjectIDVar).(string),
 		Name:      d.Get(NameVar).(string),
-		Metadata:  &management.AddSAMLAppRequest_MetadataXml{MetadataXml: []byte(d.Get(MetadataXMLVar).(string))},
-	})
+	}
+	if metadataURL := d.Get(MetadataURLVar).(string); metadataURL != "" {
+		req.Metadata = &management.AddSAMLAppRequest_MetadataUrl{MetadataUrl: metadataURL}
+	} else {
+		req.Metadata = &management.AddSAMLAppRequest_MetadataXml{MetadataXml: []byte(d.Get(MetadataXMLVar).(string))}
+	}
+	resp, err := client.AddSAMLApp(helper.CtxWithOrgID(ctx, d), req)
 	if err != nil {
 		return diag.Errorf("failed to create applicationSAML: %v", err)
 	}
@@ -127,7 +135,10 @@ func read(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagn
 	set := map[string]interface{}{
 		helper.OrgIDVar: app.GetDetails().GetResourceOwner(),
 		NameVar:         app.GetName(),
-		MetadataXMLVar:  string(app.GetSamlConfig().GetMetadataXml()),
+		MetadataURLVar:  app.GetSamlConfig().GetMetadataUrl(),
+	}
+	if metadataXML := app.GetSamlConfig().GetMetadataXml(); metadataXML != nil {
+		set[MetadataXMLVar] = string(metadataXML)
 	}
 	for k, v := range set {
 		if err := d.Set(k, v); err != nil {
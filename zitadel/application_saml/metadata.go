@@ -0,0 +1,152 @@
+package application_saml
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/pem"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	bindingHTTPPost     = "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST"
+	bindingHTTPRedirect = "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect"
+	bindingHTTPArtifact = "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Artifact"
+
+	protocolSupportSAML20 = "urn:oasis:names:tc:SAML:2.0:protocol"
+)
+
+var bindings = []string{bindingHTTPPost, bindingHTTPRedirect, bindingHTTPArtifact}
+
+type entityDescriptor struct {
+	XMLName         xml.Name        `xml:"urn:oasis:names:tc:SAML:2.0:metadata EntityDescriptor"`
+	EntityID        string          `xml:"entityID,attr"`
+	SPSSODescriptor spSSODescriptor `xml:"SPSSODescriptor"`
+}
+
+type spSSODescriptor struct {
+	ProtocolSupportEnumeration string            `xml:"protocolSupportEnumeration,attr"`
+	KeyDescriptors             []keyDescriptor   `xml:"KeyDescriptor,omitempty"`
+	SingleLogoutServices       []endpoint        `xml:"SingleLogoutService,omitempty"`
+	NameIDFormats              []string          `xml:"NameIDFormat,omitempty"`
+	AssertionConsumerServices  []indexedEndpoint `xml:"AssertionConsumerService"`
+}
+
+type keyDescriptor struct {
+	Use     string  `xml:"use,attr"`
+	KeyInfo keyInfo `xml:"http://www.w3.org/2000/09/xmldsig# KeyInfo"`
+}
+
+type keyInfo struct {
+	X509Certificate string `xml:"X509Data>X509Certificate"`
+}
+
+type endpoint struct {
+	Binding  string `xml:"Binding,attr"`
+	Location string `xml:"Location,attr"`
+}
+
+type indexedEndpoint struct {
+	Binding  string `xml:"Binding,attr"`
+	Location string `xml:"Location,attr"`
+	Index    int    `xml:"index,attr"`
+}
+
+// renderMetadata renders the SP metadata XML from the service provider block
+func renderMetadata(serviceProvider map[string]interface{}) (string, error) {
+	descriptor := entityDescriptor{
+		EntityID: serviceProvider[entityIDVar].(string),
+		SPSSODescriptor: spSSODescriptor{
+			ProtocolSupportEnumeration: protocolSupportSAML20,
+		},
+	}
+	if certificate := serviceProvider[certificateVar].(string); certificate != "" {
+		block, _ := pem.Decode([]byte(certificate))
+		if block == nil || block.Type != "CERTIFICATE" {
+			return "", errors.New("certificate must be a PEM encoded X.509 certificate")
+		}
+		descriptor.SPSSODescriptor.KeyDescriptors = []keyDescriptor{{
+			Use:     "signing",
+			KeyInfo: keyInfo{X509Certificate: base64.StdEncoding.EncodeToString(block.Bytes)},
+		}}
+	}
+	if sloURL := serviceProvider[singleLogoutURLVar].(string); sloURL != "" {
+		descriptor.SPSSODescriptor.SingleLogoutServices = []endpoint{{
+			Binding:  serviceProvider[singleLogoutBindingVar].(string),
+			Location: sloURL,
+		}}
+	}
+	if nameIDFormat := serviceProvider[nameIDFormatVar].(string); nameIDFormat != "" {
+		descriptor.SPSSODescriptor.NameIDFormats = []string{nameIDFormat}
+	}
+	for i, acs := range serviceProvider[assertionConsumerServiceVar].([]interface{}) {
+		acsMap := acs.(map[string]interface{})
+		descriptor.SPSSODescriptor.AssertionConsumerServices = append(descriptor.SPSSODescriptor.AssertionConsumerServices, indexedEndpoint{
+			Binding:  acsMap[bindingVar].(string),
+			Location: acsMap[locationVar].(string),
+			Index:    i,
+		})
+	}
+	rendered, err := xml.MarshalIndent(descriptor, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to render metadata: %w", err)
+	}
+	return xml.Header + string(rendered), nil
+}
+
+// normalizeXML returns the XML without whitespace-only text between the elements,
+// so that equivalent documents can be compared
+func normalizeXML(in string) (string, error) {
+	decoder := xml.NewDecoder(strings.NewReader(in))
+	out := &bytes.Buffer{}
+	encoder := xml.NewEncoder(out)
+	for {
+		token, err := decoder.RawToken()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return "", err
+		}
+		switch t := token.(type) {
+		case xml.CharData:
+			if len(bytes.TrimSpace(t)) == 0 {
+				continue
+			}
+			token = xml.CharData(bytes.TrimSpace(t))
+		case xml.Comment:
+			continue
+		case xml.ProcInst:
+			if t.Target == "xml" {
+				continue
+			}
+		}
+		if err := encoder.EncodeToken(xml.CopyToken(token)); err != nil {
+			return "", err
+		}
+	}
+	if err := encoder.Flush(); err != nil {
+		return "", err
+	}
+	return out.String(), nil
+}
+
+func suppressEquivalentXML(_, old, new string, _ *schema.ResourceData) bool {
+	if old == new {
+		return true
+	}
+	normalizedOld, err := normalizeXML(old)
+	if err != nil {
+		return false
+	}
+	normalizedNew, err := normalizeXML(new)
+	if err != nil {
+		return false
+	}
+	return normalizedOld == normalizedNew
+}
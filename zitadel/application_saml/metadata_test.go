@@ -0,0 +1,104 @@
+package application_saml
+
+import (
+	"strings"
+	"testing"
+)
+
+const testCertificate = `-----BEGIN CERTIFICATE-----
+MIIBhTCCASugAwIBAgIQIRi6zePL6mKjOipn+dNuaTAKBggqhkjOPQQDAjASMRAw
+DgYDVQQKEwdBY21lIENvMB4XDTE3MTAyMDE5NDMwNloXDTE4MTAyMDE5NDMwNlow
+EjEQMA4GA1UEChMHQWNtZSBDbzBZMBMGByqGSM49AgEGCCqGSM49AwEHA0IABD0d
+7VNhbWvZLWPuj/RtHFjvtJBEwOkhbN/BnnE8rnZR8+sbwnc/KhCk3FhnpHZnQz7B
+5aETbbIgmuvewdjvSBSjYzBhMA4GA1UdDwEB/wQEAwICpDATBgNVHSUEDDAKBggr
+BgEFBQcDATAPBgNVHRMBAf8EBTADAQH/MCkGA1UdEQQiMCCCDmxvY2FsaG9zdDo1
+NDUzgg4xMjcuMC4wLjE6NTQ1MzAKBggqhkjOPQQDAgNIADBFAiEA2zpJEPQyz6/l
+Wf86aX6PepsntZv2GYlA5UpabfT2EZICICpJ5h/iI+i341gBmLiAFQOyTDT+/wQc
+6MF9+Yw1Yy0t
+-----END CERTIFICATE-----`
+
+func TestRenderMetadata(t *testing.T) {
+	rendered, err := renderMetadata(map[string]interface{}{
+		entityIDVar: "http://example.com/saml/metadata",
+		assertionConsumerServiceVar: []interface{}{
+			map[string]interface{}{locationVar: "http://example.com/saml/acs", bindingVar: bindingHTTPPost},
+			map[string]interface{}{locationVar: "http://example.com/saml/acs-redirect", bindingVar: bindingHTTPRedirect},
+		},
+		singleLogoutURLVar:     "http://example.com/saml/slo",
+		singleLogoutBindingVar: bindingHTTPRedirect,
+		certificateVar:         testCertificate,
+		nameIDFormatVar:        "urn:oasis:names:tc:SAML:1.1:nameid-format:unspecified",
+	})
+	if err != nil {
+		t.Fatalf("renderMetadata() unexpected error: %v", err)
+	}
+	for _, expect := range []string{
+		`<EntityDescriptor xmlns="urn:oasis:names:tc:SAML:2.0:metadata" entityID="http://example.com/saml/metadata">`,
+		`<SPSSODescriptor protocolSupportEnumeration="urn:oasis:names:tc:SAML:2.0:protocol">`,
+		`<KeyInfo xmlns="http://www.w3.org/2000/09/xmldsig#">`,
+		`<X509Certificate>MIIBhTCCASugAwIBAgIQIRi6zePL6mKjOipn`,
+		`<SingleLogoutService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect" Location="http://example.com/saml/slo"></SingleLogoutService>`,
+		`<NameIDFormat>urn:oasis:names:tc:SAML:1.1:nameid-format:unspecified</NameIDFormat>`,
+		`<AssertionConsumerService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST" Location="http://example.com/saml/acs" index="0"></AssertionConsumerService>`,
+		`<AssertionConsumerService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect" Location="http://example.com/saml/acs-redirect" index="1"></AssertionConsumerService>`,
+	} {
+		if !strings.Contains(rendered, expect) {
+			t.Errorf("renderMetadata() expected to contain %s, got:\n%s", expect, rendered)
+		}
+	}
+}
+
+func TestRenderMetadataInvalidCertificate(t *testing.T) {
+	_, err := renderMetadata(map[string]interface{}{
+		entityIDVar:                 "http://example.com/saml/metadata",
+		assertionConsumerServiceVar: []interface{}{},
+		singleLogoutURLVar:          "",
+		singleLogoutBindingVar:      bindingHTTPRedirect,
+		certificateVar:              "not a certificate",
+		nameIDFormatVar:             "",
+	})
+	if err == nil {
+		t.Fatal("renderMetadata() expected error for invalid certificate")
+	}
+}
+
+func TestSuppressEquivalentXML(t *testing.T) {
+	compact := `<?xml version="1.0"?><md:EntityDescriptor xmlns:md="urn:oasis:names:tc:SAML:2.0:metadata" entityID="http://example.com/saml/metadata"><md:SPSSODescriptor><md:NameIDFormat>urn:oasis:names:tc:SAML:1.1:nameid-format:unspecified</md:NameIDFormat></md:SPSSODescriptor></md:EntityDescriptor>`
+	tests := []struct {
+		name     string
+		new      string
+		suppress bool
+	}{{
+		name:     "identical",
+		new:      compact,
+		suppress: true,
+	}, {
+		name: "whitespace only differences",
+		new: `<?xml version="1.0" encoding="UTF-8"?>
+<md:EntityDescriptor xmlns:md="urn:oasis:names:tc:SAML:2.0:metadata" entityID="http://example.com/saml/metadata">
+    <!-- comments are ignored -->
+    <md:SPSSODescriptor>
+        <md:NameIDFormat>
+            urn:oasis:names:tc:SAML:1.1:nameid-format:unspecified
+        </md:NameIDFormat>
+    </md:SPSSODescriptor>
+</md:EntityDescriptor>
+`,
+		suppress: true,
+	}, {
+		name:     "changed attribute",
+		new:      strings.Replace(compact, "http://example.com/saml/metadata", "http://example.com/saml/other", 1),
+		suppress: false,
+	}, {
+		name:     "invalid xml",
+		new:      "<md:EntityDescriptor>",
+		suppress: false,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := suppressEquivalentXML(MetadataXMLVar, compact, tt.new, nil); got != tt.suppress {
+				t.Errorf("suppressEquivalentXML() = %v, want %v", got, tt.suppress)
+			}
+		})
+	}
+}
@@ -1,13 +1,18 @@
 package application_saml
 
 import (
+	"context"
+	"fmt"
+	"strings"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
 )
 
 func GetResource() *schema.Resource {
-	return &schema.Resource{
+	return helper.WithDeletionProtection(&schema.Resource{
 		Description: "Resource representing a SAML application belonging to a project, with all configuration possibilities.",
 		Schema: map[string]*schema.Schema{
 			helper.OrgIDVar: helper.OrgIDResourceField,
@@ -23,19 +28,99 @@ func GetResource() *schema.Resource {
 				Description: "Name of the application",
 			},
 			MetadataXMLVar: {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "Metadata as XML file",
-				Sensitive:   true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				Description:      "Metadata as XML file, either metadata_xml, metadata_url or service_provider is required. Whitespace-only differences are ignored.",
+				Sensitive:        true,
+				ExactlyOneOf:     []string{MetadataXMLVar, MetadataURLVar, serviceProviderVar},
+				DiffSuppressFunc: suppressEquivalentXML,
+			},
+			MetadataURLVar: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "URL from which ZITADEL fetches the metadata, either metadata_xml, metadata_url or service_provider is required",
+				ExactlyOneOf: []string{MetadataXMLVar, MetadataURLVar, serviceProviderVar},
+			},
+			serviceProviderVar: {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				Description:  "Structured service provider configuration from which the metadata XML is rendered, either metadata_xml, metadata_url or service_provider is required",
+				ExactlyOneOf: []string{MetadataXMLVar, MetadataURLVar, serviceProviderVar},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						entityIDVar: {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Entity ID of the service provider",
+						},
+						assertionConsumerServiceVar: {
+							Type:        schema.TypeList,
+							Required:    true,
+							MinItems:    1,
+							Description: "Assertion consumer services of the service provider, the index is given by the order",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									locationVar: {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "URL of the assertion consumer service",
+									},
+									bindingVar: {
+										Type:             schema.TypeString,
+										Optional:         true,
+										Default:          bindingHTTPPost,
+										Description:      "Binding of the assertion consumer service, supported values: " + strings.Join(bindings, ", "),
+										ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(bindings, false)),
+									},
+								},
+							},
+						},
+						singleLogoutURLVar: {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "URL of the single logout service",
+						},
+						singleLogoutBindingVar: {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Default:          bindingHTTPRedirect,
+							Description:      "Binding of the single logout service, supported values: " + strings.Join(bindings, ", "),
+							ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(bindings, false)),
+						},
+						certificateVar: {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "PEM encoded X.509 certificate the service provider uses to sign requests",
+						},
+						nameIDFormatVar: {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Name ID format requested by the service provider, e.g. urn:oasis:names:tc:SAML:1.1:nameid-format:unspecified",
+						},
+					},
+				},
 			},
 		},
 		DeleteContext: delete,
 		CreateContext: create,
 		UpdateContext: update,
 		ReadContext:   read,
+		CustomizeDiff: func(ctx context.Context, diff *schema.ResourceDiff, m interface{}) error {
+			serviceProvider := diff.Get(serviceProviderVar).([]interface{})
+			if len(serviceProvider) == 0 || !diff.NewValueKnown(serviceProviderVar) {
+				return nil
+			}
+			metadata, err := renderMetadata(serviceProvider[0].(map[string]interface{}))
+			if err != nil {
+				return fmt.Errorf("%s: %w", serviceProviderVar, err)
+			}
+			return diff.SetNew(MetadataXMLVar, metadata)
+		},
 		Importer: helper.ImportWithIDAndOptionalOrg(
 			AppIDVar,
 			helper.NewImportAttribute(ProjectIDVar, helper.ConvertID, false),
 		),
-	}
+	}, false)
 }
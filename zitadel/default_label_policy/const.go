@@ -1,5 +1,9 @@
 package default_label_policy
 
+import (
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/label_policy_utils"
+)
+
 const (
 	PrimaryColorVar        = "primary_color"
 	hideLoginNameSuffixVar = "hide_login_name_suffix"
@@ -13,20 +17,26 @@ const (
 	disableWatermarkVar    = "disable_watermark"
 	LogoPathVar            = "logo_path"
 	LogoHashVar            = "logo_hash"
+	LogoContentVar         = "logo_content_base64"
 	logoURLVar             = "logo_url"
 	IconPathVar            = "icon_path"
 	IconHashVar            = "icon_hash"
+	IconContentVar         = "icon_content_base64"
 	iconURLVar             = "icon_url"
 	LogoDarkPathVar        = "logo_dark_path"
 	LogoDarkHashVar        = "logo_dark_hash"
+	LogoDarkContentVar     = "logo_dark_content_base64"
 	logoURLDarkVar         = "logo_url_dark"
 	IconDarkPathVar        = "icon_dark_path"
 	IconDarkHashVar        = "icon_dark_hash"
+	IconDarkContentVar     = "icon_dark_content_base64"
 	iconURLDarkVar         = "icon_url_dark"
 	FontPathVar            = "font_path"
 	FontHashVar            = "font_hash"
+	FontContentVar         = "font_content_base64"
 	fontURLVar             = "font_url"
 	SetActiveVar           = "set_active"
+	ResetToDefaultVar      = "reset_to_default"
 	themeModeVar           = "theme_mode"
 )
 
@@ -39,3 +49,23 @@ const (
 	iconDarkURL    = iconURL + "/dark"
 	fontURL        = assetAPI + labelPolicyURL + "/font"
 )
+
+// defaults of a new ZITADEL instance, which are restored if reset_to_default is enabled
+const (
+	defaultPrimaryColor        = "#5469d4"
+	defaultWarnColor           = "#cd3d56"
+	defaultBackgroundColor     = "#fafafa"
+	defaultFontColor           = "#000000"
+	defaultPrimaryColorDark    = "#2073c4"
+	defaultBackgroundColorDark = "#111827"
+	defaultWarnColorDark       = "#ff3b5b"
+	defaultFontColorDark       = "#ffffff"
+)
+
+var assets = []label_policy_utils.Asset{
+	{Name: "logo", PathVar: LogoPathVar, ContentVar: LogoContentVar, HashVar: LogoHashVar, URLVar: logoURLVar, Endpoint: logoURL, MIMETypes: label_policy_utils.ImageMIMETypes},
+	{Name: "logo_dark", PathVar: LogoDarkPathVar, ContentVar: LogoDarkContentVar, HashVar: LogoDarkHashVar, URLVar: logoURLDarkVar, Endpoint: logoDarkURL, MIMETypes: label_policy_utils.ImageMIMETypes},
+	{Name: "icon", PathVar: IconPathVar, ContentVar: IconContentVar, HashVar: IconHashVar, URLVar: iconURLVar, Endpoint: iconURL, MIMETypes: label_policy_utils.ImageMIMETypes},
+	{Name: "icon_dark", PathVar: IconDarkPathVar, ContentVar: IconDarkContentVar, HashVar: IconDarkHashVar, URLVar: iconURLDarkVar, Endpoint: iconDarkURL, MIMETypes: label_policy_utils.ImageMIMETypes},
+	{Name: "font", PathVar: FontPathVar, ContentVar: FontContentVar, HashVar: FontHashVar, URLVar: fontURLVar, Endpoint: fontURL, MIMETypes: label_policy_utils.FontMIMETypes},
+}
@@ -10,13 +10,76 @@ import (
 	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/policy"
 
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/label_policy_utils"
 )
 
 func delete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	tflog.Info(ctx, "default label policy cannot be deleted")
+	if !d.Get(ResetToDefaultVar).(bool) {
+		tflog.Info(ctx, "default label policy cannot be deleted")
+		return nil
+	}
+	tflog.Info(ctx, "started reset to default")
+
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return diag.Errorf("failed to get client")
+	}
+
+	client, err := helper.GetAdminClient(ctx, clientinfo)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	for _, asset := range assets {
+		if d.Get(asset.HashVar).(string) == "" {
+			continue
+		}
+		if err := removeAsset(ctx, clientinfo, asset); helper.IgnoreIfNotFoundError(err) != nil {
+			return diag.Errorf("failed to remove %s: %v", asset.Name, err)
+		}
+	}
+	_, err = client.UpdateLabelPolicy(ctx, &admin.UpdateLabelPolicyRequest{
+		PrimaryColor:        defaultPrimaryColor,
+		HideLoginNameSuffix: false,
+		WarnColor:           defaultWarnColor,
+		BackgroundColor:     defaultBackgroundColor,
+		FontColor:           defaultFontColor,
+		PrimaryColorDark:    defaultPrimaryColorDark,
+		BackgroundColorDark: defaultBackgroundColorDark,
+		WarnColorDark:       defaultWarnColorDark,
+		FontColorDark:       defaultFontColorDark,
+		DisableWatermark:    false,
+		ThemeMode:           policy.ThemeMode_THEME_MODE_AUTO,
+	})
+	if helper.IgnorePreconditionError(err) != nil {
+		return diag.Errorf("failed to reset default label policy: %v", err)
+	}
+	if _, err := client.ActivateLabelPolicy(ctx, &admin.ActivateLabelPolicyRequest{}); err != nil {
+		return diag.Errorf("failed to activate default label policy: %v", err)
+	}
 	return nil
 }
 
+func removeAsset(ctx context.Context, clientinfo *helper.ClientInfo, asset label_policy_utils.Asset) error {
+	client, err := helper.GetAdminClient(ctx, clientinfo)
+	if err != nil {
+		return err
+	}
+	switch asset.Endpoint {
+	case logoURL:
+		_, err = client.RemoveLabelPolicyLogo(ctx, &admin.RemoveLabelPolicyLogoRequest{})
+	case logoDarkURL:
+		_, err = client.RemoveLabelPolicyLogoDark(ctx, &admin.RemoveLabelPolicyLogoDarkRequest{})
+	case iconURL:
+		_, err = client.RemoveLabelPolicyIcon(ctx, &admin.RemoveLabelPolicyIconRequest{})
+	case iconDarkURL:
+		_, err = client.RemoveLabelPolicyIconDark(ctx, &admin.RemoveLabelPolicyIconDarkRequest{})
+	case fontURL:
+		_, err = client.RemoveLabelPolicyFont(ctx, &admin.RemoveLabelPolicyFontRequest{})
+	}
+	return err
+}
+
 func update(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	tflog.Info(ctx, "started update")
 
@@ -73,29 +136,18 @@ func update(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Dia
 	}
 	d.SetId(id)
 
-	if d.HasChanges(LogoHashVar, LogoPathVar) {
-		if err := helper.InstanceFormFilePost(ctx, clientinfo, logoURL, d.Get(LogoPathVar).(string)); err != nil {
-			return diag.Errorf("failed to upload logo: %v", err)
-		}
-	}
-	if d.HasChanges(LogoDarkHashVar, LogoDarkPathVar) {
-		if err := helper.InstanceFormFilePost(ctx, clientinfo, logoDarkURL, d.Get(LogoDarkPathVar).(string)); err != nil {
-			return diag.Errorf("failed to upload logo dark: %v", err)
-		}
-	}
-	if d.HasChanges(IconHashVar, IconPathVar) {
-		if err := helper.InstanceFormFilePost(ctx, clientinfo, iconURL, d.Get(IconPathVar).(string)); err != nil {
-			return diag.Errorf("failed to upload icon: %v", err)
+	for _, asset := range assets {
+		if !d.HasChanges(asset.HashVar, asset.PathVar, asset.ContentVar) {
+			continue
 		}
-	}
-	if d.HasChanges(IconDarkHashVar, IconDarkPathVar) {
-		if err := helper.InstanceFormFilePost(ctx, clientinfo, iconDarkURL, d.Get(IconDarkPathVar).(string)); err != nil {
-			return diag.Errorf("failed to upload icon dark: %v", err)
+		if !asset.IsConfigured(d) {
+			if err := removeAsset(ctx, clientinfo, asset); err != nil {
+				return diag.Errorf("failed to remove %s: %v", asset.Name, err)
+			}
+			continue
 		}
-	}
-	if d.HasChanges(FontHashVar, FontPathVar) {
-		if err := helper.InstanceFormFilePost(ctx, clientinfo, fontURL, d.Get(FontPathVar).(string)); err != nil {
-			return diag.Errorf("failed to upload font: %v", err)
+		if err := asset.Upload(ctx, clientinfo, d, ""); err != nil {
+			return err
 		}
 	}
 
@@ -115,6 +167,11 @@ func update(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Dia
 		IconHashVar,
 		IconDarkHashVar,
 		FontHashVar,
+		LogoContentVar,
+		LogoDarkContentVar,
+		IconContentVar,
+		IconDarkContentVar,
+		FontContentVar,
 		themeModeVar,
 	) {
 		if d.Get(SetActiveVar).(bool) {
@@ -168,6 +225,16 @@ func read(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagn
 		themeModeVar:           policy.GetThemeMode().String(),
 	}
 
+	for _, asset := range assets {
+		hash, err := asset.RemoteHash(ctx, clientinfo, set[asset.URLVar].(string))
+		if err != nil {
+			// an unavailable asset shouldn't fail the whole read, so the previous hash is kept
+			tflog.Warn(ctx, "failed to download asset, keeping the previous hash", map[string]interface{}{"url": set[asset.URLVar], "error": err.Error()})
+			hash = d.Get(asset.HashVar).(string)
+		}
+		set[asset.HashVar] = hash
+	}
+
 	for k, v := range set {
 		if err := d.Set(k, v); err != nil {
 			return diag.Errorf("failed to set %s of default label policy: %v", k, err)
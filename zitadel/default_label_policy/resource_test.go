@@ -53,6 +53,7 @@ func TestAccDefaultLabelPolicy(t *testing.T) {
 		default_label_policy.IconDarkPathVar,
 		default_label_policy.FontHashVar,
 		default_label_policy.FontPathVar,
+		default_label_policy.ResetToDefaultVar,
 	)
 }
 
@@ -0,0 +1,7 @@
+package default_language
+
+const (
+	LanguageVar                      = "language"
+	allowedLanguagesVar              = "allowed_languages"
+	disallowPublicOrgRegistrationVar = "disallow_public_org_registration"
+)
@@ -0,0 +1,30 @@
+package default_language
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func GetDatasource() *schema.Resource {
+	return &schema.Resource{
+		Description: "Datasource representing the default language and the restrictions of the instance.",
+		Schema: map[string]*schema.Schema{
+			LanguageVar: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Default language of the instance",
+			},
+			allowedLanguagesVar: {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Languages the users of the instance are allowed to use",
+			},
+			disallowPublicOrgRegistrationVar: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Is the public registration of new organizations disallowed",
+			},
+		},
+		ReadContext: read,
+	}
+}
@@ -0,0 +1,117 @@
+package default_language
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/admin"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
+)
+
+func delete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Warn(ctx, "default language cannot be deleted")
+	return nil
+}
+
+func update(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started update")
+
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return diag.Errorf("failed to get client")
+	}
+
+	client, err := helper.GetAdminClient(ctx, clientinfo)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	language := d.Get(LanguageVar).(string)
+	disallowPublicOrgRegistration := d.Get(disallowPublicOrgRegistrationVar).(bool)
+	restrictions := &admin.SetRestrictionsRequest{
+		DisallowPublicOrgRegistration: &disallowPublicOrgRegistration,
+	}
+	allowedLanguages := helper.GetOkSetToStringSlice(d, allowedLanguagesVar)
+	if len(allowedLanguages) > 0 {
+		// the current default language can't be disallowed and the new default language has to be allowed,
+		// so both stay allowed until the default language is changed
+		currentResp, err := client.GetDefaultLanguage(ctx, &admin.GetDefaultLanguageRequest{})
+		if err != nil {
+			return diag.Errorf("failed to get default language: %v", err)
+		}
+		transitionLanguages := allowedLanguages
+		if current := currentResp.GetLanguage(); !slices.Contains(allowedLanguages, current) {
+			transitionLanguages = append([]string{current}, allowedLanguages...)
+		}
+		_, err = client.SetRestrictions(ctx, &admin.SetRestrictionsRequest{
+			AllowedLanguages: &admin.SelectLanguages{List: transitionLanguages},
+		})
+		if err != nil && helper.IgnorePreconditionError(err) != nil {
+			return diag.Errorf("failed to set allowed languages: %v", err)
+		}
+		restrictions.AllowedLanguages = &admin.SelectLanguages{List: allowedLanguages}
+	}
+	_, err = client.SetDefaultLanguage(ctx, &admin.SetDefaultLanguageRequest{
+		Language: language,
+	})
+	if err != nil && helper.IgnorePreconditionError(err) != nil {
+		return diag.Errorf("failed to set default language: %v", err)
+	}
+	_, err = client.SetRestrictions(ctx, restrictions)
+	if err != nil && helper.IgnorePreconditionError(err) != nil {
+		return diag.Errorf("failed to set restrictions: %v", err)
+	}
+	return read(ctx, d, m)
+}
+
+func read(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started read")
+
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return diag.Errorf("failed to get client")
+	}
+
+	client, err := helper.GetAdminClient(ctx, clientinfo)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	languageResp, err := client.GetDefaultLanguage(ctx, &admin.GetDefaultLanguageRequest{})
+	if err != nil {
+		return diag.Errorf("failed to get default language: %v", err)
+	}
+	restrictionsResp, err := client.GetRestrictions(ctx, &admin.GetRestrictionsRequest{})
+	if err != nil {
+		return diag.Errorf("failed to get restrictions: %v", err)
+	}
+	set := map[string]interface{}{
+		LanguageVar:                      languageResp.GetLanguage(),
+		allowedLanguagesVar:              restrictionsResp.GetAllowedLanguages(),
+		disallowPublicOrgRegistrationVar: restrictionsResp.GetDisallowPublicOrgRegistration(),
+	}
+	for k, v := range set {
+		if err := d.Set(k, v); err != nil {
+			return diag.Errorf("failed to set %s of default language: %v", k, err)
+		}
+	}
+	d.SetId(restrictionsResp.GetDetails().GetResourceOwner())
+	return nil
+}
+
+func customizeDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if !diff.NewValueKnown(LanguageVar) || !diff.NewValueKnown(allowedLanguagesVar) {
+		return nil
+	}
+	allowedLanguages := helper.SetToStringSlice(diff.Get(allowedLanguagesVar).(*schema.Set))
+	language := diff.Get(LanguageVar).(string)
+	if len(allowedLanguages) > 0 && !slices.Contains(allowedLanguages, language) {
+		return fmt.Errorf("%s: the default language %s has to be one of the %s %v", LanguageVar, language, allowedLanguagesVar, allowedLanguages)
+	}
+	return nil
+}
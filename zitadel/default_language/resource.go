@@ -0,0 +1,39 @@
+package default_language
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
+)
+
+func GetResource() *schema.Resource {
+	return &schema.Resource{
+		Description: "Resource representing the default language and the restrictions of the instance.",
+		Schema: map[string]*schema.Schema{
+			LanguageVar: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Default language of the instance, for example \"en\"",
+			},
+			allowedLanguagesVar: {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Languages the users of the instance are allowed to use, the default language has to be one of them. If not set, the remote value is kept",
+			},
+			disallowPublicOrgRegistrationVar: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Disallow the public registration of new organizations",
+			},
+		},
+		CreateContext: update,
+		UpdateContext: update,
+		DeleteContext: delete,
+		ReadContext:   read,
+		CustomizeDiff: customizeDiff,
+		Importer:      helper.ImportWithEmptyID(),
+	}
+}
@@ -0,0 +1,51 @@
+package default_language_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/admin"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/default_language"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper/test_utils"
+)
+
+func TestAccDefaultLanguage(t *testing.T) {
+	frame := test_utils.NewInstanceTestFrame(t, "zitadel_default_language")
+	resourceExample, exampleAttributes := test_utils.ReadExample(t, test_utils.Resources, frame.ResourceType)
+	exampleProperty := test_utils.AttributeValue(t, default_language.LanguageVar, exampleAttributes).AsString()
+	test_utils.RunLifecyleTest(
+		t,
+		frame.BaseTestFrame,
+		nil,
+		func(property, _ string) string {
+			return test_utils.ReplaceAll(resourceExample, fmt.Sprintf(`"%s"`, exampleProperty), "")(fmt.Sprintf(`"%s"`, property), "")
+		},
+		exampleProperty, "de",
+		"", "", "",
+		false,
+		checkRemoteProperty(*frame),
+		helper.ZitadelGeneratedIdOnlyRegex,
+		test_utils.CheckNothing,
+		test_utils.ImportNothing,
+	)
+}
+
+func checkRemoteProperty(frame test_utils.InstanceTestFrame) func(string) resource.TestCheckFunc {
+	return func(expect string) resource.TestCheckFunc {
+		return func(state *terraform.State) error {
+			resp, err := frame.GetDefaultLanguage(frame, &admin.GetDefaultLanguageRequest{})
+			if err != nil {
+				return fmt.Errorf("getting default language failed: %w", err)
+			}
+			actual := resp.GetLanguage()
+			if actual != expect {
+				return fmt.Errorf("expected %s, but got %s", expect, actual)
+			}
+			return nil
+		}
+	}
+}
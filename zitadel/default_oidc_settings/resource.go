@@ -11,24 +11,32 @@ func GetResource() *schema.Resource {
 		Description: "Resource representing the default oidc settings.",
 		Schema: map[string]*schema.Schema{
 			accessTokenLifetimeVar: {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "lifetime duration of access tokens",
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "lifetime duration of access tokens",
+				ValidateDiagFunc: helper.DurationValidation,
+				DiffSuppressFunc: helper.SuppressEquivalentDurations,
 			},
 			idTokenLifetimeVar: {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "lifetime duration of id tokens",
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "lifetime duration of id tokens",
+				ValidateDiagFunc: helper.DurationValidation,
+				DiffSuppressFunc: helper.SuppressEquivalentDurations,
 			},
 			RefreshTokenExpirationVar: {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "expiration duration of refresh tokens",
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "expiration duration of refresh tokens",
+				ValidateDiagFunc: helper.DurationValidation,
+				DiffSuppressFunc: helper.SuppressEquivalentDurations,
 			},
 			refreshTokenIdleExpirationVar: {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "expiration duration of idle refresh tokens",
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "expiration duration of idle refresh tokens",
+				ValidateDiagFunc: helper.DurationValidation,
+				DiffSuppressFunc: helper.SuppressEquivalentDurations,
 			},
 		},
 		CreateContext: update,
@@ -5,4 +5,5 @@ const (
 	isVerifiedVar     = "is_verified"
 	isPrimaryVar      = "is_primary"
 	validationTypeVar = "validation_type"
+	domainsVar        = "domains"
 )
@@ -0,0 +1,57 @@
+package domain
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/org"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
+)
+
+func ListDatasources() *schema.Resource {
+	return &schema.Resource{
+		Description: "Datasource representing the domains of an organization, optionally filtered by their verification and primary state.",
+		Schema: map[string]*schema.Schema{
+			helper.OrgIDVar: helper.OrgIDDatasourceField,
+			isVerifiedVar: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Only return verified domains if true, only return unverified domains if false",
+			},
+			isPrimaryVar: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Only return the primary domain if true, only return the other domains if false",
+			},
+			domainsVar: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Domains of the organization matching the filters",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						NameVar: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the domain",
+						},
+						isVerifiedVar: {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Is domain verified",
+						},
+						isPrimaryVar: {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Is domain primary",
+						},
+						validationTypeVar: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Validation type" + helper.DescriptionEnumValuesList(org.DomainValidationType_name),
+						},
+					},
+				},
+			},
+		},
+		ReadContext: list,
+	}
+}
@@ -0,0 +1,94 @@
+package domain_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/management"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper/test_utils"
+)
+
+const domainsDatasourceFilter = "is_verified = true"
+
+func TestAccDomainsDatasource_Verified(t *testing.T) {
+	frame, config, unverifiedDomain := setupDomainsDatasource(t)
+	test_utils.RunDatasourceTest(
+		t,
+		frame.BaseTestFrame,
+		config,
+		[]string{frame.AsOrgDefaultDependency},
+		checkRemoteProperty(frame)(unverifiedDomain),
+		map[string]string{
+			"domains.#":             "1",
+			"domains.0.is_verified": "true",
+			"domains.0.is_primary":  "true",
+		},
+	)
+}
+
+func TestAccDomainsDatasource_Unverified(t *testing.T) {
+	frame, config, unverifiedDomain := setupDomainsDatasource(t)
+	config = strings.Replace(config, domainsDatasourceFilter, "is_verified = false", 1)
+	test_utils.RunDatasourceTest(
+		t,
+		frame.BaseTestFrame,
+		config,
+		[]string{frame.AsOrgDefaultDependency},
+		checkRemoteProperty(frame)(unverifiedDomain),
+		map[string]string{
+			"domains.#":             "1",
+			"domains.0.name":        unverifiedDomain,
+			"domains.0.is_verified": "false",
+			"domains.0.is_primary":  "false",
+		},
+	)
+}
+
+func TestAccDomainsDatasource_NotPrimary(t *testing.T) {
+	frame, config, unverifiedDomain := setupDomainsDatasource(t)
+	config = strings.Replace(config, domainsDatasourceFilter, "is_primary = false", 1)
+	test_utils.RunDatasourceTest(
+		t,
+		frame.BaseTestFrame,
+		config,
+		[]string{frame.AsOrgDefaultDependency},
+		checkRemoteProperty(frame)(unverifiedDomain),
+		map[string]string{
+			"domains.#":            "1",
+			"domains.0.name":       unverifiedDomain,
+			"domains.0.is_primary": "false",
+		},
+	)
+}
+
+func TestAccDomainsDatasource_Unfiltered(t *testing.T) {
+	frame, config, unverifiedDomain := setupDomainsDatasource(t)
+	config = strings.Replace(config, domainsDatasourceFilter, "", 1)
+	test_utils.RunDatasourceTest(
+		t,
+		frame.BaseTestFrame,
+		config,
+		[]string{frame.AsOrgDefaultDependency},
+		checkRemoteProperty(frame)(unverifiedDomain),
+		map[string]string{
+			"domains.#": "2",
+		},
+	)
+}
+
+// setupDomainsDatasource creates an org, which has its generated primary domain and an added unverified domain
+func setupDomainsDatasource(t *testing.T) (*test_utils.OrgTestFrame, string, string) {
+	datasourceName := "zitadel_domains"
+	frame := test_utils.NewOrgTestFrame(t, datasourceName)
+	config, _ := test_utils.ReadExample(t, test_utils.Datasources, datasourceName)
+	if !strings.Contains(config, domainsDatasourceFilter) {
+		t.Fatalf("expected the example to filter by %s", domainsDatasourceFilter)
+	}
+	otherFrame := frame.AnotherOrg(t, "domains_datasource_"+frame.UniqueResourcesID)
+	unverifiedDomain := strings.ToLower(frame.UniqueResourcesID) + ".unverified.default.127.0.0.1.sslip.io"
+	if _, err := otherFrame.AddOrgDomain(otherFrame, &management.AddOrgDomainRequest{Domain: unverifiedDomain}); err != nil {
+		t.Fatalf("failed to add domain: %v", err)
+	}
+	return otherFrame, config, unverifiedDomain
+}
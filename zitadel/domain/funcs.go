@@ -161,3 +161,44 @@ func read(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagn
 	d.SetId("")
 	return nil
 }
+
+func list(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started list")
+
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return diag.Errorf("failed to get client")
+	}
+
+	client, err := helper.GetManagementClient(ctx, clientinfo)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resp, err := client.ListOrgDomains(helper.CtxWithOrgID(ctx, d), &management.ListOrgDomainsRequest{})
+	if err != nil {
+		return diag.Errorf("failed to list domains: %v", err)
+	}
+	// the filters are only applied if they are configured, as an unset bool can't be distinguished from false otherwise
+	rawConfig := d.GetRawConfig()
+	filterVerified := !rawConfig.GetAttr(isVerifiedVar).IsNull()
+	filterPrimary := !rawConfig.GetAttr(isPrimaryVar).IsNull()
+	domains := make([]map[string]interface{}, 0, len(resp.GetResult()))
+	for _, domain := range resp.GetResult() {
+		if filterVerified && domain.GetIsVerified() != d.Get(isVerifiedVar).(bool) {
+			continue
+		}
+		if filterPrimary && domain.GetIsPrimary() != d.Get(isPrimaryVar).(bool) {
+			continue
+		}
+		domains = append(domains, map[string]interface{}{
+			NameVar:           domain.GetDomainName(),
+			isVerifiedVar:     domain.GetIsVerified(),
+			isPrimaryVar:      domain.GetIsPrimary(),
+			validationTypeVar: domain.GetValidationType().String(),
+		})
+	}
+	// If the ID is blank, the datasource is deleted and not usable.
+	d.SetId("-")
+	return diag.FromErr(d.Set(domainsVar, domains))
+}
@@ -27,6 +27,7 @@ func GetResource() *schema.Resource {
 				Optional:    true,
 				Description: "Is domain primary",
 				Default:     false,
+				Deprecated:  "use the resource zitadel_org_primary_domain instead, which ensures that only one domain is primary",
 			},
 			validationTypeVar: {
 				Type:        schema.TypeInt,
@@ -0,0 +1,14 @@
+package domain_verification
+
+const (
+	DomainVar         = "domain"
+	validationTypeVar = "validation_type"
+	validateVar       = "validate"
+	tokenVar          = "token"
+	urlVar            = "url"
+	dnsRecordNameVar  = "dns_record_name"
+	dnsRecordValueVar = "dns_record_value"
+	httpPathVar       = "http_path"
+	httpContentVar    = "http_content"
+	isVerifiedVar     = "is_verified"
+)
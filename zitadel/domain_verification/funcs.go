@@ -0,0 +1,188 @@
+package domain_verification
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	managementclient "github.com/zitadel/zitadel-go/v3/pkg/client/management"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/management"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/object"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/org"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
+)
+
+func delete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started delete")
+	tflog.Info(ctx, "domain verification cannot be deleted, it is only removed from the state")
+	return nil
+}
+
+func create(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started create")
+
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return diag.Errorf("failed to get client")
+	}
+
+	client, err := helper.GetManagementClient(ctx, clientinfo)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	domainName := d.Get(DomainVar).(string)
+	validationType := org.DomainValidationType(org.DomainValidationType_value[d.Get(validationTypeVar).(string)])
+	resp, err := client.GenerateOrgDomainValidation(helper.CtxWithOrgID(ctx, d), &management.GenerateOrgDomainValidationRequest{
+		Domain: domainName,
+		Type:   validationType,
+	})
+	if err != nil {
+		return diag.Errorf("failed to generate domain validation: %v", err)
+	}
+	d.SetId(domainName)
+
+	set := map[string]interface{}{
+		tokenVar:          resp.GetToken(),
+		urlVar:            resp.GetUrl(),
+		dnsRecordNameVar:  "",
+		dnsRecordValueVar: "",
+		httpPathVar:       "",
+		httpContentVar:    "",
+	}
+	switch validationType {
+	case org.DomainValidationType_DOMAIN_VALIDATION_TYPE_DNS:
+		set[dnsRecordNameVar] = resp.GetUrl()
+		set[dnsRecordValueVar] = resp.GetToken()
+	case org.DomainValidationType_DOMAIN_VALIDATION_TYPE_HTTP:
+		httpURL, err := url.Parse(resp.GetUrl())
+		if err != nil {
+			return diag.Errorf("failed to parse domain validation url %s: %v", resp.GetUrl(), err)
+		}
+		set[httpPathVar] = httpURL.Path
+		set[httpContentVar] = resp.GetToken()
+	}
+	for k, v := range set {
+		if err := d.Set(k, v); err != nil {
+			return diag.Errorf("failed to set %s of domain verification: %v", k, err)
+		}
+	}
+	return read(ctx, d, m)
+}
+
+func update(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started update")
+
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return diag.Errorf("failed to get client")
+	}
+
+	client, err := helper.GetManagementClient(ctx, clientinfo)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange(validateVar) && d.Get(validateVar).(bool) && !d.Get(isVerifiedVar).(bool) {
+		if err := validate(ctx, client, d, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			// keep validate false in the state, so that the next apply tries again
+			if setErr := d.Set(validateVar, false); setErr != nil {
+				return diag.Errorf("failed to set %s of domain verification: %v", validateVar, setErr)
+			}
+			return diag.FromErr(err)
+		}
+	}
+	return read(ctx, d, m)
+}
+
+// validate asks ZITADEL to verify the domain until it succeeds or the timeout expires,
+// so that DNS records and files have time to propagate
+func validate(ctx context.Context, client *managementclient.Client, d *schema.ResourceData, timeout time.Duration) error {
+	err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		_, err := client.ValidateOrgDomain(helper.CtxWithOrgID(ctx, d), &management.ValidateOrgDomainRequest{Domain: d.Id()})
+		if err == nil {
+			return nil
+		}
+		if !isNotVerifiedYet(err) {
+			return retry.NonRetryableError(err)
+		}
+		tflog.Debug(ctx, "domain not verified yet", map[string]interface{}{"domain": d.Id(), "error": err.Error()})
+		return retry.RetryableError(err)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to verify domain %s: %w", d.Id(), err)
+	}
+	return nil
+}
+
+// isNotVerifiedYet returns whether the validation failed because the verification token isn't found yet.
+// Other failures like missing permissions or an unknown org or domain aren't resolved by retrying
+func isNotVerifiedYet(err error) bool {
+	switch s, _ := status.FromError(err); s.Code() {
+	case codes.FailedPrecondition:
+		return true
+	case codes.NotFound:
+		return strings.Contains(strings.ToLower(s.Message()), "token")
+	default:
+		return false
+	}
+}
+
+func read(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started read")
+
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return diag.Errorf("failed to get client")
+	}
+
+	client, err := helper.GetManagementClient(ctx, clientinfo)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resp, err := client.ListOrgDomains(helper.CtxWithOrgID(ctx, d), &management.ListOrgDomainsRequest{
+		Queries: []*org.DomainSearchQuery{{
+			Query: &org.DomainSearchQuery_DomainNameQuery{
+				DomainNameQuery: &org.DomainNameQuery{
+					Name:   d.Id(),
+					Method: object.TextQueryMethod_TEXT_QUERY_METHOD_EQUALS,
+				},
+			},
+		}},
+	})
+	if err != nil && helper.IgnoreIfNotFoundError(err) == nil {
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.Errorf("failed to list domains: %v", err)
+	}
+	for _, domain := range resp.GetResult() {
+		if domain.GetDomainName() != d.Id() {
+			continue
+		}
+		set := map[string]interface{}{
+			helper.OrgIDVar: domain.GetOrgId(),
+			DomainVar:       domain.GetDomainName(),
+			isVerifiedVar:   domain.GetIsVerified(),
+		}
+		for k, v := range set {
+			if err := d.Set(k, v); err != nil {
+				return diag.Errorf("failed to set %s of domain verification: %v", k, err)
+			}
+		}
+		return nil
+	}
+	d.SetId("")
+	return nil
+}
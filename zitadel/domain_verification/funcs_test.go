@@ -0,0 +1,44 @@
+package domain_verification
+
+import (
+	"errors"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestIsNotVerifiedYet(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		expect bool
+	}{{
+		name:   "failed precondition is retried",
+		err:    status.Error(codes.FailedPrecondition, "Domain verification failed"),
+		expect: true,
+	}, {
+		name:   "missing token is retried",
+		err:    status.Error(codes.NotFound, "Verification token not found"),
+		expect: true,
+	}, {
+		name: "missing domain is not retried",
+		err:  status.Error(codes.NotFound, "Domain doesn't exist on organization"),
+	}, {
+		name: "missing permission is not retried",
+		err:  status.Error(codes.PermissionDenied, "No matching permissions found"),
+	}, {
+		name: "invalid argument is not retried",
+		err:  status.Error(codes.InvalidArgument, "Domain is invalid"),
+	}, {
+		name: "unknown error is not retried",
+		err:  errors.New("connection refused"),
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isNotVerifiedYet(tt.err); got != tt.expect {
+				t.Errorf("isNotVerifiedYet() = %t, want %t", got, tt.expect)
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package domain_verification
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/org"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
+)
+
+func GetResource() *schema.Resource {
+	return &schema.Resource{
+		Description: "Resource representing the verification of a domain of the organization. " +
+			"On creation, a validation token is generated, which has to be published as DNS TXT record or HTTP file, as described by the computed attributes. " +
+			"As the token is only known after the creation, the domain is verified as soon as validate is set to true in a subsequent apply. " +
+			"ZITADEL is then asked to verify the domain until it succeeds or the update timeout expires. " +
+			"If the verification fails, validate stays false in the state, so that the next apply tries again.",
+		Schema: map[string]*schema.Schema{
+			helper.OrgIDVar: helper.OrgIDResourceField,
+			DomainVar: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the domain, which has to be added to the organization, for example by a zitadel_domain resource",
+				ForceNew:    true,
+			},
+			validationTypeVar: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Type of the validation" + helper.DescriptionEnumValuesList(validationTypes()),
+				ForceNew:    true,
+				Default:     org.DomainValidationType_DOMAIN_VALIDATION_TYPE_DNS.String(),
+				ValidateDiagFunc: func(value interface{}, path cty.Path) diag.Diagnostics {
+					return helper.EnumValueValidation(validationTypeVar, value, helper.EnumValueMap(validationTypes()))
+				},
+			},
+			validateVar: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Verify the domain, set it to true after the validation token is published. It must be false when the resource is created or replaced, as a new token is generated then",
+			},
+			tokenVar: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Generated validation token",
+			},
+			urlVar: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Location where ZITADEL expects the validation token, as returned by ZITADEL",
+			},
+			dnsRecordNameVar: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name of the DNS TXT record, only set for the validation type DOMAIN_VALIDATION_TYPE_DNS",
+			},
+			dnsRecordValueVar: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Value of the DNS TXT record, only set for the validation type DOMAIN_VALIDATION_TYPE_DNS",
+			},
+			httpPathVar: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Path of the file served on the domain, only set for the validation type DOMAIN_VALIDATION_TYPE_HTTP",
+			},
+			httpContentVar: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Content of the file served on the domain, only set for the validation type DOMAIN_VALIDATION_TYPE_HTTP",
+			},
+			isVerifiedVar: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Is the domain verified",
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+		CustomizeDiff: customizeDiffValidate,
+		CreateContext: create,
+		UpdateContext: update,
+		DeleteContext: delete,
+		ReadContext:   read,
+	}
+}
+
+// customizeDiffValidate rejects validate on creation and replacement,
+// as the validation token is generated then and can't be published yet
+func customizeDiffValidate(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if !diff.Get(validateVar).(bool) {
+		return nil
+	}
+	if diff.Id() == "" || diff.HasChanges(helper.OrgIDVar, DomainVar, validationTypeVar) {
+		return fmt.Errorf("%s must be false when the domain verification is created or replaced, set it to true after the new validation token is published", validateVar)
+	}
+	return nil
+}
+
+// validationTypes returns the validation types without the unspecified value
+func validationTypes() map[int32]string {
+	types := make(map[int32]string, len(org.DomainValidationType_name))
+	for k, v := range org.DomainValidationType_name {
+		if k != int32(org.DomainValidationType_DOMAIN_VALIDATION_TYPE_UNSPECIFIED) {
+			types[k] = v
+		}
+	}
+	return types
+}
@@ -0,0 +1,96 @@
+package domain_verification_test
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/management"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/object"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/org"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper/test_utils"
+)
+
+func TestAccDomainVerification(t *testing.T) {
+	frame := test_utils.NewOrgTestFrame(t, "zitadel_domain_verification")
+	resourceExample, _ := test_utils.ReadExample(t, test_utils.Resources, frame.ResourceType)
+	exampleProperty := "verify.default.127.0.0.1.sslip.io"
+	updatedProperty := "updated.verify.default.127.0.0.1.sslip.io"
+	test_utils.RunLifecyleTest(
+		t,
+		frame.BaseTestFrame,
+		[]string{frame.AsOrgDefaultDependency},
+		test_utils.ReplaceAll(resourceExample, exampleProperty, ""),
+		exampleProperty, updatedProperty,
+		"", "", "",
+		true,
+		checkRemoteProperty(frame),
+		regexp.MustCompile(fmt.Sprintf(`^%s$|^%s$`, exampleProperty, updatedProperty)),
+		test_utils.CheckNothing,
+		nil,
+	)
+}
+
+func checkRemoteProperty(frame *test_utils.OrgTestFrame) func(string) resource.TestCheckFunc {
+	return func(expect string) resource.TestCheckFunc {
+		return func(_ *terraform.State) error {
+			resp, err := frame.ListOrgDomains(frame, &management.ListOrgDomainsRequest{
+				Queries: []*org.DomainSearchQuery{{
+					Query: &org.DomainSearchQuery_DomainNameQuery{
+						DomainNameQuery: &org.DomainNameQuery{
+							Name:   expect,
+							Method: object.TextQueryMethod_TEXT_QUERY_METHOD_EQUALS,
+						},
+					},
+				}},
+			})
+			if err != nil {
+				return err
+			}
+			if len(resp.GetResult()) == 0 {
+				return fmt.Errorf("expected to find %s, but didn't: %w", expect, test_utils.ErrNotFound)
+			}
+			if actual := resp.GetResult()[0].GetValidationType(); actual != org.DomainValidationType_DOMAIN_VALIDATION_TYPE_DNS {
+				return fmt.Errorf("expected validation type %s, but got %s", org.DomainValidationType_DOMAIN_VALIDATION_TYPE_DNS, actual)
+			}
+			return nil
+		}
+	}
+}
+
+func TestAccDomainVerificationValidate(t *testing.T) {
+	frame := test_utils.NewOrgTestFrame(t, "zitadel_domain_verification")
+	resourceExample, _ := test_utils.ReadExample(t, test_utils.Resources, frame.ResourceType)
+	// the domain is not resolvable, so the validation fails quickly
+	domain := fmt.Sprintf("%s.verify.invalid", strings.ToLower(frame.UniqueResourcesID))
+	config := func(validate bool) string {
+		cfg := strings.Replace(resourceExample, "verify.default.127.0.0.1.sslip.io", domain, 1)
+		cfg = strings.Replace(cfg, "validate = false", fmt.Sprintf("validate = %t\n  timeouts {\n    update = \"10s\"\n  }", validate), 1)
+		return fmt.Sprintf("%s\n%s\n%s", frame.ProviderSnippet, frame.AsOrgDefaultDependency, cfg)
+	}
+	resource.ParallelTest(t, resource.TestCase{
+		Steps: []resource.TestStep{{ // Validating on creation is rejected, as the token can't be published yet
+			Config:      config(true),
+			PlanOnly:    true,
+			ExpectError: regexp.MustCompile("validate must be false when the domain verification is created"),
+		}, { // The token is generated without validation
+			Config: config(false),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttrSet(frame.TerraformName, "token"),
+				resource.TestCheckResourceAttr(frame.TerraformName, "is_verified", "false"),
+			),
+		}, { // Validating an unpublished token fails
+			Config:      config(true),
+			ExpectError: regexp.MustCompile("failed to verify domain"),
+		}, { // validate stays false in the state, so the next apply tries again with the same token
+			Config:             config(true),
+			PlanOnly:           true,
+			ExpectNonEmptyPlan: true,
+		}},
+		ProtoV6ProviderFactories: frame.ProtoV6ProviderFactories(),
+	})
+}
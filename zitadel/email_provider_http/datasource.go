@@ -0,0 +1,56 @@
+package email_provider_http
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/settings"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
+)
+
+func ListDatasources() *schema.Resource {
+	return &schema.Resource{
+		Description: "Datasource representing all email providers of an instance, regardless of their type.",
+		Schema: map[string]*schema.Schema{
+			providersVar: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "All configured email providers",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						idVar: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the email provider",
+						},
+						typeVar: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Type of the email provider, supported values: " + typeSMTP + ", " + typeHTTP,
+						},
+						DescriptionVar: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Description of the email provider",
+						},
+						stateVar: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "State of the email provider" + helper.DescriptionEnumValuesList(settings.EmailProviderState_name),
+						},
+						EndpointVar: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Endpoint the emails are posted to, only set for the type " + typeHTTP,
+						},
+					},
+				},
+			},
+			activeProviderIDVar: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the active email provider, empty if no email provider is active",
+			},
+		},
+		ReadContext: list,
+	}
+}
@@ -0,0 +1,188 @@
+package email_provider_http
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/admin"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/settings"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
+)
+
+func delete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started delete")
+
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return diag.Errorf("failed to get client")
+	}
+
+	client, err := helper.GetAdminClient(ctx, clientinfo)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = client.RemoveEmailProvider(ctx, &admin.RemoveEmailProviderRequest{Id: d.Id()})
+	if err != nil {
+		return diag.Errorf("failed to delete email provider http: %v", err)
+	}
+	return nil
+}
+
+func create(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started create")
+
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return diag.Errorf("failed to get client")
+	}
+
+	client, err := helper.GetAdminClient(ctx, clientinfo)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resp, err := client.AddEmailProviderHTTP(ctx, &admin.AddEmailProviderHTTPRequest{
+		Endpoint:    d.Get(EndpointVar).(string),
+		Description: d.Get(DescriptionVar).(string),
+	})
+	if err != nil {
+		return diag.Errorf("failed to create email provider http: %v", err)
+	}
+	d.SetId(resp.GetId())
+
+	if d.Get(SetActiveVar).(bool) {
+		if _, err := client.ActivateEmailProvider(ctx, &admin.ActivateEmailProviderRequest{Id: d.Id()}); err != nil {
+			return diag.Errorf("failed to activate email provider http: %v", err)
+		}
+	}
+	return read(ctx, d, m)
+}
+
+func update(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started update")
+
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return diag.Errorf("failed to get client")
+	}
+
+	client, err := helper.GetAdminClient(ctx, clientinfo)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChanges(EndpointVar, DescriptionVar) {
+		_, err = client.UpdateEmailProviderHTTP(ctx, &admin.UpdateEmailProviderHTTPRequest{
+			Id:          d.Id(),
+			Endpoint:    d.Get(EndpointVar).(string),
+			Description: d.Get(DescriptionVar).(string),
+		})
+		if err != nil {
+			return diag.Errorf("failed to update email provider http: %v", err)
+		}
+	}
+
+	if d.HasChange(SetActiveVar) {
+		if d.Get(SetActiveVar).(bool) {
+			_, err = client.ActivateEmailProvider(ctx, &admin.ActivateEmailProviderRequest{Id: d.Id()})
+		} else {
+			_, err = client.DeactivateEmailProvider(ctx, &admin.DeactivateEmailProviderRequest{Id: d.Id()})
+		}
+		if err != nil {
+			return diag.Errorf("failed to change activation of email provider http: %v", err)
+		}
+	}
+	return read(ctx, d, m)
+}
+
+func read(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started read")
+
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return diag.Errorf("failed to get client")
+	}
+
+	client, err := helper.GetAdminClient(ctx, clientinfo)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resp, err := client.GetEmailProviderById(ctx, &admin.GetEmailProviderByIdRequest{
+		Id: d.Id(),
+	})
+	if err != nil && helper.IgnoreIfNotFoundError(err) == nil {
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.Errorf("failed to get email provider http")
+	}
+
+	set := map[string]interface{}{
+		EndpointVar:    resp.GetConfig().GetHttp().GetEndpoint(),
+		DescriptionVar: resp.GetConfig().GetDescription(),
+		SetActiveVar:   resp.GetConfig().GetState() == settings.EmailProviderState_EMAIL_PROVIDER_ACTIVE,
+		stateVar:       resp.GetConfig().GetState().String(),
+	}
+	for k, v := range set {
+		if err := d.Set(k, v); err != nil {
+			return diag.Errorf("failed to set %s of email provider http: %v", k, err)
+		}
+	}
+	d.SetId(resp.GetConfig().GetId())
+	return nil
+}
+
+func list(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started list")
+
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return diag.Errorf("failed to get client")
+	}
+
+	client, err := helper.GetAdminClient(ctx, clientinfo)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resp, err := client.ListEmailProviders(ctx, &admin.ListEmailProvidersRequest{})
+	if err != nil {
+		return diag.Errorf("failed to list email providers: %v", err)
+	}
+	providers := make([]map[string]interface{}, len(resp.GetResult()))
+	activeProviderID := ""
+	for i, provider := range resp.GetResult() {
+		providerType := typeSMTP
+		if provider.GetHttp() != nil {
+			providerType = typeHTTP
+		}
+		providers[i] = map[string]interface{}{
+			idVar:          provider.GetId(),
+			typeVar:        providerType,
+			DescriptionVar: provider.GetDescription(),
+			stateVar:       provider.GetState().String(),
+			EndpointVar:    provider.GetHttp().GetEndpoint(),
+		}
+		if provider.GetState() == settings.EmailProviderState_EMAIL_PROVIDER_ACTIVE {
+			activeProviderID = provider.GetId()
+		}
+	}
+	set := map[string]interface{}{
+		providersVar:        providers,
+		activeProviderIDVar: activeProviderID,
+	}
+	for k, v := range set {
+		if err := d.Set(k, v); err != nil {
+			return diag.Errorf("failed to set %s of email providers: %v", k, err)
+		}
+	}
+	// If the ID is blank, the datasource is deleted and not usable.
+	d.SetId("-")
+	return nil
+}
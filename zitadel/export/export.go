@@ -0,0 +1,101 @@
+package export
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	adminclient "github.com/zitadel/zitadel-go/v3/pkg/client/admin"
+	mgmt "github.com/zitadel/zitadel-go/v3/pkg/client/management"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/admin"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/object"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/org"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
+)
+
+const pageSize = 100
+
+// Exporter walks the resources of a ZITADEL instance and collects import blocks for them
+type Exporter struct {
+	admin *adminclient.Client
+	mgmt  *mgmt.Client
+	names names
+}
+
+func NewExporter(ctx context.Context, clientinfo *helper.ClientInfo) (*Exporter, error) {
+	adminClient, err := helper.GetAdminClient(ctx, clientinfo)
+	if err != nil {
+		return nil, err
+	}
+	mgmtClient, err := helper.GetManagementClient(ctx, clientinfo)
+	if err != nil {
+		return nil, err
+	}
+	return &Exporter{
+		admin: adminClient,
+		mgmt:  mgmtClient,
+		names: make(names),
+	}, nil
+}
+
+// Export collects the import blocks of the instance and of every organization
+func (e *Exporter) Export(ctx context.Context) ([]*File, error) {
+	instance, err := e.instance(ctx)
+	if err != nil {
+		return nil, err
+	}
+	files := []*File{instance}
+	orgs, err := list(func(query *object.ListQuery) ([]*org.Org, error) {
+		resp, err := e.admin.ListOrgs(ctx, &admin.ListOrgsRequest{Query: query})
+		return resp.GetResult(), err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list orgs: %w", err)
+	}
+	for _, org := range orgs {
+		file, err := e.org(ctx, org.GetId(), org.GetName())
+		if err != nil {
+			return nil, fmt.Errorf("failed to export org %s: %w", org.GetName(), err)
+		}
+		files = append(files, file)
+	}
+	return files, nil
+}
+
+// WriteFiles writes the files to the directory
+func WriteFiles(dir string, files []*File) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	for _, file := range files {
+		if err := os.WriteFile(filepath.Join(dir, file.Name), file.Render(), 0644); err != nil {
+			return fmt.Errorf("failed to write %s: %w", file.Name, err)
+		}
+	}
+	return nil
+}
+
+func (e *Exporter) add(file *File, resourceType, id string, nameParts ...string) {
+	file.Imports = append(file.Imports, Import{
+		ResourceType: resourceType,
+		Name:         e.names.unique(resourceType, nameParts...),
+		ID:           id,
+	})
+}
+
+// list requests all pages of a list query
+func list[T any](fetch func(query *object.ListQuery) ([]T, error)) ([]T, error) {
+	var all []T
+	for offset := uint64(0); ; offset += pageSize {
+		result, err := fetch(&object.ListQuery{Offset: offset, Limit: pageSize})
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, result...)
+		if len(result) < pageSize {
+			return all, nil
+		}
+	}
+}
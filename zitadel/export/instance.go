@@ -0,0 +1,152 @@
+package export
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/admin"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/idp"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/instance"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/member"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/object"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/settings"
+)
+
+// defaultPolicies are the instance settings which always exist and are imported with an empty ID
+var defaultPolicies = []string{
+	"zitadel_default_domain_policy",
+	"zitadel_default_language",
+	"zitadel_default_label_policy",
+	"zitadel_default_lockout_policy",
+	"zitadel_default_login_policy",
+	"zitadel_default_notification_policy",
+	"zitadel_default_oidc_settings",
+	"zitadel_default_password_complexity_policy",
+	"zitadel_default_privacy_policy",
+	"zitadel_instance_features",
+	"zitadel_instance_name",
+	"zitadel_security_policy",
+}
+
+// idpResourceTypes maps the provider types to the resource types of the instance and of organizations
+var idpResourceTypes = map[idp.ProviderType][2]string{
+	idp.ProviderType_PROVIDER_TYPE_OIDC:               {"zitadel_idp_oidc", "zitadel_org_idp_oidc"},
+	idp.ProviderType_PROVIDER_TYPE_JWT:                {"zitadel_idp_jwt", "zitadel_org_idp_jwt"},
+	idp.ProviderType_PROVIDER_TYPE_LDAP:               {"zitadel_idp_ldap", "zitadel_org_idp_ldap"},
+	idp.ProviderType_PROVIDER_TYPE_OAUTH:              {"zitadel_idp_oauth", "zitadel_org_idp_oauth"},
+	idp.ProviderType_PROVIDER_TYPE_AZURE_AD:           {"zitadel_idp_azure_ad", "zitadel_org_idp_azure_ad"},
+	idp.ProviderType_PROVIDER_TYPE_GITHUB:             {"zitadel_idp_github", "zitadel_org_idp_github"},
+	idp.ProviderType_PROVIDER_TYPE_GITHUB_ES:          {"zitadel_idp_github_es", "zitadel_org_idp_github_es"},
+	idp.ProviderType_PROVIDER_TYPE_GITLAB:             {"zitadel_idp_gitlab", "zitadel_org_idp_gitlab"},
+	idp.ProviderType_PROVIDER_TYPE_GITLAB_SELF_HOSTED: {"zitadel_idp_gitlab_self_hosted", "zitadel_org_idp_gitlab_self_hosted"},
+	idp.ProviderType_PROVIDER_TYPE_GOOGLE:             {"zitadel_idp_google", "zitadel_org_idp_google"},
+	idp.ProviderType_PROVIDER_TYPE_SAML:               {"zitadel_idp_saml", "zitadel_org_idp_saml"},
+	idp.ProviderType_PROVIDER_TYPE_APPLE:              {"zitadel_idp_apple", "zitadel_org_idp_apple"},
+}
+
+func (e *Exporter) instance(ctx context.Context) (*File, error) {
+	file := &File{Name: "instance.tf"}
+	for _, resourceType := range defaultPolicies {
+		e.add(file, resourceType, "", "default")
+	}
+
+	providers, err := list(func(query *object.ListQuery) ([]*idp.Provider, error) {
+		resp, err := e.admin.ListProviders(ctx, &admin.ListProvidersRequest{Query: query})
+		return resp.GetResult(), err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list idps: %w", err)
+	}
+	for _, provider := range providers {
+		if resourceType := idpResourceTypes[provider.GetType()][0]; resourceType != "" {
+			e.add(file, resourceType, provider.GetId(), provider.GetName())
+		}
+	}
+
+	generators, err := e.admin.ListSecretGenerators(ctx, &admin.ListSecretGeneratorsRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list secret generators: %w", err)
+	}
+	for _, generator := range generators.GetResult() {
+		generatorType := generator.GetGeneratorType().String()
+		e.add(file, "zitadel_secret_generator", generatorType, strings.ToLower(strings.TrimPrefix(generatorType, "SECRET_GENERATOR_TYPE_")))
+	}
+
+	trustedDomains, err := list(func(query *object.ListQuery) ([]*instance.TrustedDomain, error) {
+		resp, err := e.admin.ListInstanceTrustedDomains(ctx, &admin.ListInstanceTrustedDomainsRequest{Query: query})
+		return resp.GetResult(), err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list trusted domains: %w", err)
+	}
+	for _, trustedDomain := range trustedDomains {
+		e.add(file, "zitadel_instance_trusted_domain", trustedDomain.GetDomain(), trustedDomain.GetDomain())
+	}
+
+	domains, err := list(func(query *object.ListQuery) ([]*instance.Domain, error) {
+		resp, err := e.admin.ListInstanceDomains(ctx, &admin.ListInstanceDomainsRequest{Query: query})
+		return resp.GetResult(), err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list instance domains: %w", err)
+	}
+	for _, domain := range domains {
+		// generated domains are created and removed together with the instance
+		if !domain.GetGenerated() {
+			e.add(file, "zitadel_instance_domain", domain.GetDomain(), domain.GetDomain())
+		}
+	}
+
+	members, err := list(func(query *object.ListQuery) ([]*member.Member, error) {
+		resp, err := e.admin.ListIAMMembers(ctx, &admin.ListIAMMembersRequest{Query: query})
+		return resp.GetResult(), err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list instance members: %w", err)
+	}
+	for _, member := range members {
+		e.add(file, "zitadel_instance_member", member.GetUserId(), member.GetPreferredLoginName())
+	}
+
+	smtpConfigs, err := list(func(query *object.ListQuery) ([]*settings.SMTPConfig, error) {
+		resp, err := e.admin.ListSMTPConfigs(ctx, &admin.ListSMTPConfigsRequest{Query: query})
+		return resp.GetResult(), err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list smtp configs: %w", err)
+	}
+	for _, smtpConfig := range smtpConfigs {
+		e.add(file, "zitadel_smtp_config", smtpConfig.GetId(), "smtp", smtpConfig.GetDescription())
+	}
+
+	smsProviders, err := list(func(query *object.ListQuery) ([]*settings.SMSProvider, error) {
+		resp, err := e.admin.ListSMSProviders(ctx, &admin.ListSMSProvidersRequest{Query: query})
+		return resp.GetResult(), err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sms providers: %w", err)
+	}
+	for _, smsProvider := range smsProviders {
+		if smsProvider.GetTwilio() != nil {
+			e.add(file, "zitadel_sms_provider_twilio", smsProvider.GetId(), "twilio", smsProvider.GetDescription())
+		}
+		if smsProvider.GetHttp() != nil {
+			e.add(file, "zitadel_sms_provider_http", smsProvider.GetId(), "http", smsProvider.GetDescription())
+		}
+	}
+
+	emailProviders, err := list(func(query *object.ListQuery) ([]*settings.EmailProvider, error) {
+		resp, err := e.admin.ListEmailProviders(ctx, &admin.ListEmailProvidersRequest{Query: query})
+		return resp.GetResult(), err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list email providers: %w", err)
+	}
+	for _, emailProvider := range emailProviders {
+		if emailProvider.GetHttp() != nil {
+			e.add(file, "zitadel_email_provider_http", emailProvider.GetId(), "http", emailProvider.GetDescription())
+		}
+	}
+	return file, nil
+}
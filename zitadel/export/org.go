@@ -0,0 +1,261 @@
+package export
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/action"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/admin"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/app"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/idp"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/management"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/member"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/object"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/project"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/user"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
+)
+
+func (e *Exporter) org(ctx context.Context, orgID, orgName string) (*File, error) {
+	file := &File{Name: "org_" + e.names.unique("file", orgName) + ".tf"}
+	ctx = helper.CtxSetOrgID(ctx, orgID)
+	e.add(file, "zitadel_org", orgID, orgName)
+
+	if err := e.orgPolicies(ctx, file, orgID, orgName); err != nil {
+		return nil, err
+	}
+	steps := []func(context.Context, *File, string, string) error{
+		e.orgMembers,
+		e.orgIDPs,
+		e.actions,
+		e.users,
+		e.projects,
+		e.userGrants,
+	}
+	for _, step := range steps {
+		if err := step(ctx, file, orgID, orgName); err != nil {
+			return nil, err
+		}
+	}
+	return file, nil
+}
+
+// orgPolicies adds the policies which are customized for the organization
+func (e *Exporter) orgPolicies(ctx context.Context, file *File, orgID, orgName string) error {
+	custom := map[string]func() (bool, error){
+		"zitadel_domain_policy": func() (bool, error) {
+			resp, err := e.admin.GetCustomDomainPolicy(ctx, &admin.GetCustomDomainPolicyRequest{OrgId: orgID})
+			if err != nil {
+				return false, helper.IgnoreIfNotFoundError(err)
+			}
+			return !resp.GetPolicy().GetIsDefault(), nil
+		},
+		"zitadel_label_policy": func() (bool, error) {
+			resp, err := e.mgmt.GetPreviewLabelPolicy(ctx, &management.GetPreviewLabelPolicyRequest{})
+			return !resp.GetPolicy().GetIsDefault(), err
+		},
+		"zitadel_lockout_policy": func() (bool, error) {
+			resp, err := e.mgmt.GetLockoutPolicy(ctx, &management.GetLockoutPolicyRequest{})
+			return !resp.GetPolicy().GetIsDefault(), err
+		},
+		"zitadel_login_policy": func() (bool, error) {
+			resp, err := e.mgmt.GetLoginPolicy(ctx, &management.GetLoginPolicyRequest{})
+			return !resp.GetPolicy().GetIsDefault(), err
+		},
+		"zitadel_notification_policy": func() (bool, error) {
+			resp, err := e.mgmt.GetNotificationPolicy(ctx, &management.GetNotificationPolicyRequest{})
+			return !resp.GetPolicy().GetIsDefault(), err
+		},
+		"zitadel_password_complexity_policy": func() (bool, error) {
+			resp, err := e.mgmt.GetPasswordComplexityPolicy(ctx, &management.GetPasswordComplexityPolicyRequest{})
+			return !resp.GetPolicy().GetIsDefault(), err
+		},
+		"zitadel_privacy_policy": func() (bool, error) {
+			resp, err := e.mgmt.GetPrivacyPolicy(ctx, &management.GetPrivacyPolicyRequest{})
+			return !resp.GetPolicy().GetIsDefault(), err
+		},
+	}
+	for _, resourceType := range []string{
+		"zitadel_domain_policy",
+		"zitadel_label_policy",
+		"zitadel_lockout_policy",
+		"zitadel_login_policy",
+		"zitadel_notification_policy",
+		"zitadel_password_complexity_policy",
+		"zitadel_privacy_policy",
+	} {
+		isCustom, err := custom[resourceType]()
+		if err != nil {
+			return fmt.Errorf("failed to get %s: %w", resourceType, err)
+		}
+		if isCustom {
+			e.add(file, resourceType, orgID, orgName)
+		}
+	}
+	return nil
+}
+
+func (e *Exporter) orgMembers(ctx context.Context, file *File, orgID, orgName string) error {
+	members, err := list(func(query *object.ListQuery) ([]*member.Member, error) {
+		resp, err := e.mgmt.ListOrgMembers(ctx, &management.ListOrgMembersRequest{Query: query})
+		return resp.GetResult(), err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list org members: %w", err)
+	}
+	for _, member := range members {
+		e.add(file, "zitadel_org_member", member.GetUserId()+":"+orgID, orgName, member.GetPreferredLoginName())
+	}
+	return nil
+}
+
+func (e *Exporter) orgIDPs(ctx context.Context, file *File, orgID, orgName string) error {
+	providers, err := list(func(query *object.ListQuery) ([]*idp.Provider, error) {
+		resp, err := e.mgmt.ListProviders(ctx, &management.ListProvidersRequest{Query: query})
+		return resp.GetResult(), err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list idps: %w", err)
+	}
+	for _, provider := range providers {
+		if provider.GetOwner() != idp.IDPOwnerType_IDP_OWNER_TYPE_ORG {
+			continue
+		}
+		if resourceType := idpResourceTypes[provider.GetType()][1]; resourceType != "" {
+			e.add(file, resourceType, provider.GetId()+":"+orgID, orgName, provider.GetName())
+		}
+	}
+	return nil
+}
+
+func (e *Exporter) actions(ctx context.Context, file *File, orgID, orgName string) error {
+	actions, err := list(func(query *object.ListQuery) ([]*action.Action, error) {
+		resp, err := e.mgmt.ListActions(ctx, &management.ListActionsRequest{Query: query})
+		return resp.GetResult(), err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list actions: %w", err)
+	}
+	for _, action := range actions {
+		e.add(file, "zitadel_action", action.GetId()+":"+orgID, orgName, action.GetName())
+	}
+	return nil
+}
+
+func (e *Exporter) users(ctx context.Context, file *File, orgID, orgName string) error {
+	users, err := list(func(query *object.ListQuery) ([]*user.User, error) {
+		resp, err := e.mgmt.ListUsers(ctx, &management.ListUsersRequest{Query: query})
+		return resp.GetResult(), err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list users: %w", err)
+	}
+	for _, user := range users {
+		switch {
+		case user.GetHuman() != nil:
+			e.add(file, "zitadel_human_user", user.GetId()+":"+orgID, orgName, user.GetUserName())
+		case user.GetMachine() != nil:
+			// the secret of a machine user is not readable, so it is imported without
+			e.add(file, "zitadel_machine_user", user.GetId()+":false:"+orgID, orgName, user.GetUserName())
+		}
+	}
+	return nil
+}
+
+func (e *Exporter) projects(ctx context.Context, file *File, orgID, orgName string) error {
+	projects, err := list(func(query *object.ListQuery) ([]*project.Project, error) {
+		resp, err := e.mgmt.ListProjects(ctx, &management.ListProjectsRequest{Query: query})
+		return resp.GetResult(), err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list projects: %w", err)
+	}
+	for _, project := range projects {
+		if err := e.project(ctx, file, orgID, orgName, project.GetId(), project.GetName()); err != nil {
+			return fmt.Errorf("failed to export project %s: %w", project.GetName(), err)
+		}
+	}
+	return nil
+}
+
+func (e *Exporter) project(ctx context.Context, file *File, orgID, orgName, projectID, projectName string) error {
+	e.add(file, "zitadel_project", projectID+":"+orgID, orgName, projectName)
+
+	roles, err := list(func(query *object.ListQuery) ([]*project.Role, error) {
+		resp, err := e.mgmt.ListProjectRoles(ctx, &management.ListProjectRolesRequest{ProjectId: projectID, Query: query})
+		return resp.GetResult(), err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list roles: %w", err)
+	}
+	for _, role := range roles {
+		e.add(file, "zitadel_project_role", projectID+":"+role.GetKey()+":"+orgID, orgName, projectName, role.GetKey())
+	}
+
+	apps, err := list(func(query *object.ListQuery) ([]*app.App, error) {
+		resp, err := e.mgmt.ListApps(ctx, &management.ListAppsRequest{ProjectId: projectID, Query: query})
+		return resp.GetResult(), err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list apps: %w", err)
+	}
+	for _, app := range apps {
+		id := app.GetId() + ":" + projectID + ":" + orgID
+		switch {
+		case app.GetOidcConfig() != nil:
+			e.add(file, "zitadel_application_oidc", id, orgName, projectName, app.GetName())
+		case app.GetApiConfig() != nil:
+			e.add(file, "zitadel_application_api", id, orgName, projectName, app.GetName())
+		case app.GetSamlConfig() != nil:
+			e.add(file, "zitadel_application_saml", id, orgName, projectName, app.GetName())
+		}
+	}
+
+	members, err := list(func(query *object.ListQuery) ([]*member.Member, error) {
+		resp, err := e.mgmt.ListProjectMembers(ctx, &management.ListProjectMembersRequest{ProjectId: projectID, Query: query})
+		return resp.GetResult(), err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list members: %w", err)
+	}
+	for _, member := range members {
+		e.add(file, "zitadel_project_member", projectID+":"+member.GetUserId()+":"+orgID, orgName, projectName, member.GetPreferredLoginName())
+	}
+
+	grants, err := list(func(query *object.ListQuery) ([]*project.GrantedProject, error) {
+		resp, err := e.mgmt.ListProjectGrants(ctx, &management.ListProjectGrantsRequest{ProjectId: projectID, Query: query})
+		return resp.GetResult(), err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list grants: %w", err)
+	}
+	for _, grant := range grants {
+		e.add(file, "zitadel_project_grant", grant.GetGrantId()+":"+projectID+":"+orgID, orgName, projectName, grant.GetGrantedOrgName())
+		grantMembers, err := list(func(query *object.ListQuery) ([]*member.Member, error) {
+			resp, err := e.mgmt.ListProjectGrantMembers(ctx, &management.ListProjectGrantMembersRequest{ProjectId: projectID, GrantId: grant.GetGrantId(), Query: query})
+			return resp.GetResult(), err
+		})
+		if err != nil {
+			return fmt.Errorf("failed to list grant members: %w", err)
+		}
+		for _, member := range grantMembers {
+			e.add(file, "zitadel_project_grant_member", projectID+":"+grant.GetGrantId()+":"+member.GetUserId()+":"+orgID, orgName, projectName, grant.GetGrantedOrgName(), member.GetPreferredLoginName())
+		}
+	}
+	return nil
+}
+
+func (e *Exporter) userGrants(ctx context.Context, file *File, orgID, orgName string) error {
+	grants, err := list(func(query *object.ListQuery) ([]*user.UserGrant, error) {
+		resp, err := e.mgmt.ListUserGrants(ctx, &management.ListUserGrantRequest{Query: query})
+		return resp.GetResult(), err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list user grants: %w", err)
+	}
+	for _, grant := range grants {
+		e.add(file, "zitadel_user_grant", grant.GetId()+":"+grant.GetUserId()+":"+orgID, orgName, grant.GetUserName(), grant.GetProjectName())
+	}
+	return nil
+}
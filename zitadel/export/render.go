@@ -0,0 +1,67 @@
+package export
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/zclconf/go-cty/cty"
+)
+
+const header = `# Generated by "terraform-provider-zitadel export".
+# Run "terraform plan -generate-config-out=generated.tf" to generate the configuration of the imported resources.
+# Secrets like client secrets and passwords are not readable from ZITADEL and have to be added to the generated configuration.
+`
+
+var invalidNameChars = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// Import is a resource which is imported by an import block
+type Import struct {
+	ResourceType string
+	Name         string
+	ID           string
+}
+
+// File is a generated .tf file containing import blocks
+type File struct {
+	Name    string
+	Imports []Import
+}
+
+// names makes sure every resource address is unique across all generated files
+type names map[string]bool
+
+// unique returns a valid terraform resource name from the parts which is unique for the resource type
+func (n names) unique(resourceType string, parts ...string) string {
+	name := invalidNameChars.ReplaceAllString(strings.ToLower(strings.Join(parts, "_")), "_")
+	name = strings.Trim(name, "_")
+	if name == "" || name[0] >= '0' && name[0] <= '9' {
+		name = "r_" + name
+	}
+	unique := name
+	for i := 2; n[resourceType+"."+unique]; i++ {
+		unique = name + "_" + strconv.Itoa(i)
+	}
+	n[resourceType+"."+unique] = true
+	return unique
+}
+
+// Render returns the HCL of the file
+func (f *File) Render() []byte {
+	file := hclwrite.NewEmptyFile()
+	body := file.Body()
+	for i, imp := range f.Imports {
+		if i > 0 {
+			body.AppendNewline()
+		}
+		block := body.AppendNewBlock("import", nil).Body()
+		block.SetAttributeTraversal("to", hcl.Traversal{
+			hcl.TraverseRoot{Name: imp.ResourceType},
+			hcl.TraverseAttr{Name: imp.Name},
+		})
+		block.SetAttributeValue("id", cty.StringVal(imp.ID))
+	}
+	return append([]byte(header+"\n"), file.Bytes()...)
+}
@@ -0,0 +1,47 @@
+package export
+
+import (
+	"testing"
+)
+
+func TestNamesUnique(t *testing.T) {
+	n := make(names)
+	tests := []struct {
+		resourceType string
+		parts        []string
+		want         string
+	}{
+		{resourceType: "zitadel_project", parts: []string{"ZITADEL", "My Project"}, want: "zitadel_my_project"},
+		{resourceType: "zitadel_project", parts: []string{"zitadel", "my-project"}, want: "zitadel_my_project_2"},
+		{resourceType: "zitadel_project", parts: []string{"zitadel", "my_project_2"}, want: "zitadel_my_project_2_2"},
+		{resourceType: "zitadel_org", parts: []string{"zitadel", "my project"}, want: "zitadel_my_project"},
+		{resourceType: "zitadel_org", parts: []string{"1st org"}, want: "r_1st_org"},
+		{resourceType: "zitadel_org", parts: []string{"äöü"}, want: "r_"},
+	}
+	for _, tt := range tests {
+		if got := n.unique(tt.resourceType, tt.parts...); got != tt.want {
+			t.Errorf("unique(%s, %v) = %s, want %s", tt.resourceType, tt.parts, got, tt.want)
+		}
+	}
+}
+
+func TestFileRender(t *testing.T) {
+	file := &File{Name: "instance.tf", Imports: []Import{
+		{ResourceType: "zitadel_default_login_policy", Name: "default", ID: ""},
+		{ResourceType: "zitadel_project", Name: "zitadel_my_project", ID: "123456789012345678:123456789012345679"},
+	}}
+	want := header + `
+import {
+  to = zitadel_default_login_policy.default
+  id = ""
+}
+
+import {
+  to = zitadel_project.zitadel_my_project
+  id = "123456789012345678:123456789012345679"
+}
+`
+	if got := string(file.Render()); got != want {
+		t.Errorf("Render() = %s, want %s", got, want)
+	}
+}
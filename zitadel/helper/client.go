@@ -11,7 +11,10 @@ import (
 	"github.com/zitadel/zitadel-go/v3/pkg/client/admin"
 	"github.com/zitadel/zitadel-go/v3/pkg/client/management"
 	"github.com/zitadel/zitadel-go/v3/pkg/client/middleware"
+	orgv2 "github.com/zitadel/zitadel-go/v3/pkg/client/org/v2"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/system"
 	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel"
+	adminpb "github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/admin"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -23,17 +26,22 @@ const (
 	PortVar        = "port"
 	JWTProfileFile = "jwt_profile_file"
 	JWTProfileJSON = "jwt_profile_json"
+	SystemAPIUser  = "system_api_user"
+	SystemAPIKey   = "system_api_key"
 )
 
 type ClientInfo struct {
-	Domain  string
-	Issuer  string
-	KeyPath string
-	Data    []byte
-	Options []zitadel.Option
+	Domain        string
+	Issuer        string
+	KeyPath       string
+	Data          []byte
+	Options       []zitadel.Option
+	Insecure      bool
+	SystemAPIUser string
+	SystemAPIKey  []byte
 }
 
-func GetClientInfo(ctx context.Context, insecure bool, domain string, token string, jwtProfileFile string, jwtProfileJSON string, port string) (*ClientInfo, error) {
+func GetClientInfo(ctx context.Context, insecure bool, domain string, token string, jwtProfileFile string, jwtProfileJSON string, port string, systemAPIUser string, systemAPIKey string) (*ClientInfo, error) {
 	options := make([]zitadel.Option, 0)
 	keyPath := ""
 	if token != "" {
@@ -72,12 +80,19 @@ func GetClientInfo(ctx context.Context, insecure bool, domain string, token stri
 		}
 	}
 
+	if (systemAPIUser == "") != (systemAPIKey == "") {
+		return nil, fmt.Errorf("'%s' and '%s' are only valid together", SystemAPIUser, SystemAPIKey)
+	}
+
 	return &ClientInfo{
 		clientDomain,
 		issuer,
 		keyPath,
 		[]byte(jwtProfileJSON),
 		options,
+		insecure,
+		systemAPIUser,
+		[]byte(systemAPIKey),
 	}, nil
 }
 
@@ -127,6 +142,72 @@ func GetManagementClient(ctx context.Context, info *ClientInfo) (*management.Cli
 	return mgmtClient, nil
 }
 
+var orgV2ClientLock = &sync.Mutex{}
+var orgV2Client *orgv2.Client
+
+func GetOrgV2Client(ctx context.Context, info *ClientInfo) (*orgv2.Client, error) {
+	if orgV2Client == nil {
+		orgV2ClientLock.Lock()
+		defer orgV2ClientLock.Unlock()
+		if orgV2Client == nil {
+			client, err := orgv2.NewClient(ctx,
+				info.Issuer, info.Domain,
+				[]string{oidc.ScopeOpenID, zitadel.ScopeZitadelAPI()},
+				info.Options...,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("failed to start zitadel client: %v", err)
+			}
+			time.Sleep(time.Second * 2)
+			orgV2Client = client
+		}
+	}
+	return orgV2Client, nil
+}
+
+var systemClientLock = &sync.Mutex{}
+var systemClient *system.Client
+
+// GetSystemClient returns a client for the system API, which is authenticated with the system API user instead of the service account
+func GetSystemClient(ctx context.Context, info *ClientInfo) (*system.Client, error) {
+	if info.SystemAPIUser == "" {
+		return nil, fmt.Errorf("'%s' and '%s' must be configured in the provider to use the system API", SystemAPIUser, SystemAPIKey)
+	}
+	if systemClient == nil {
+		systemClientLock.Lock()
+		defer systemClientLock.Unlock()
+		if systemClient == nil {
+			options := make([]system.Option, 0)
+			if info.Insecure {
+				options = append(options, system.WithInsecure())
+			}
+			client, err := system.NewClient(ctx,
+				info.Issuer, info.Domain,
+				system.JWTProfileFromKey(info.SystemAPIKey, info.SystemAPIUser),
+				options...,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("failed to start zitadel system client: %v", err)
+			}
+			systemClient = client
+		}
+	}
+	return systemClient, nil
+}
+
+// GetInstanceID returns the ID of the instance the provider is connected to, which the system API requires
+func GetInstanceID(ctx context.Context, info *ClientInfo) (string, error) {
+	client, err := GetAdminClient(ctx, info)
+	if err != nil {
+		return "", err
+	}
+	resp, err := client.GetMyInstance(ctx, &adminpb.GetMyInstanceRequest{})
+	if err != nil {
+		return "", fmt.Errorf("failed to get instance: %v", err)
+	}
+	return resp.GetInstance().GetId(), nil
+}
+
 func CtxWithID(ctx context.Context, d *schema.ResourceData) context.Context {
 	return CtxSetOrgID(ctx, GetID(d, OrgIDVar))
 }
@@ -0,0 +1,60 @@
+package helper
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	DeletionProtectionVar = "deletion_protection"
+)
+
+// WithDeletionProtection adds the deletion_protection attribute to the resource and wraps its delete function,
+// so that the remote object is not removed as long as the attribute is true.
+// In contrast to the lifecycle argument prevent_destroy, the protection is stored in the state,
+// so it also applies if the resource block is removed from the configuration or the resource has to be replaced.
+func WithDeletionProtection(resource *schema.Resource, protectedByDefault bool) *schema.Resource {
+	resource.Schema[DeletionProtectionVar] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     protectedByDefault,
+		Description: fmt.Sprintf("Refuses to remove the remote object on destroy or replacement, defaults to %t. Set it to false and apply before destroying the resource.", protectedByDefault),
+	}
+	deleteContext := resource.DeleteContext
+	resource.DeleteContext = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		if diags := CheckDeletionProtection(d); diags != nil {
+			return diags
+		}
+		return deleteContext(ctx, d, m)
+	}
+	// resources which can't be updated still need to change the flag in place, as a replacement would be refused
+	if resource.UpdateContext == nil {
+		resource.UpdateContext = func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+			return nil
+		}
+	}
+	if resource.Importer != nil && resource.Importer.StateContext != nil {
+		stateContext := resource.Importer.StateContext
+		resource.Importer = &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+				// the attribute is not known remotely, so imported resources start with the default
+				if err := d.Set(DeletionProtectionVar, protectedByDefault); err != nil {
+					return nil, fmt.Errorf("failed to set %s: %w", DeletionProtectionVar, err)
+				}
+				return stateContext(ctx, d, m)
+			},
+		}
+	}
+	return resource
+}
+
+// CheckDeletionProtection returns an error diagnostic if the deletion_protection attribute of the resource is true
+func CheckDeletionProtection(d *schema.ResourceData) diag.Diagnostics {
+	if protected, ok := d.Get(DeletionProtectionVar).(bool); ok && protected {
+		return diag.Errorf("%s is protected against deletion, set %s to false and apply before destroying it", d.Id(), DeletionProtectionVar)
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package helper
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestWithDeletionProtection(t *testing.T) {
+	tests := []struct {
+		name               string
+		protectedByDefault bool
+		state              map[string]interface{}
+		expectDeleted      bool
+	}{{
+		name:               "unprotected by default",
+		protectedByDefault: false,
+		state:              map[string]interface{}{},
+		expectDeleted:      true,
+	}, {
+		name:               "protected by default",
+		protectedByDefault: true,
+		state:              map[string]interface{}{},
+		expectDeleted:      false,
+	}, {
+		name:               "explicitly protected",
+		protectedByDefault: false,
+		state:              map[string]interface{}{DeletionProtectionVar: true},
+		expectDeleted:      false,
+	}, {
+		name:               "explicitly unprotected",
+		protectedByDefault: true,
+		state:              map[string]interface{}{DeletionProtectionVar: false},
+		expectDeleted:      true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deleted := false
+			resource := WithDeletionProtection(&schema.Resource{
+				Schema: map[string]*schema.Schema{},
+				DeleteContext: func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+					deleted = true
+					return nil
+				},
+			}, tt.protectedByDefault)
+			d := schema.TestResourceDataRaw(t, resource.Schema, tt.state)
+			d.SetId("123456789012345678")
+			diags := resource.DeleteContext(context.Background(), d, nil)
+			if deleted != tt.expectDeleted {
+				t.Errorf("delete called = %t, want %t", deleted, tt.expectDeleted)
+			}
+			if diags.HasError() == tt.expectDeleted {
+				t.Errorf("unexpected diagnostics: %v", diags)
+			}
+		})
+	}
+}
+
+func TestWithDeletionProtection_WithoutUpdate(t *testing.T) {
+	resource := WithDeletionProtection(&schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {Type: schema.TypeString, Required: true, ForceNew: true},
+		},
+		DeleteContext: func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+			return nil
+		},
+	}, true)
+	if resource.UpdateContext == nil {
+		t.Fatal("expected an update function, so the protection can be changed in place")
+	}
+	if resource.Schema[DeletionProtectionVar].ForceNew {
+		t.Errorf("expected %s not to force a replacement, which the protection would refuse", DeletionProtectionVar)
+	}
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{"name": "name", DeletionProtectionVar: false})
+	if diags := resource.UpdateContext(context.Background(), d, nil); diags.HasError() {
+		t.Errorf("unexpected diagnostics: %v", diags)
+	}
+}
@@ -0,0 +1,41 @@
+package helper
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DurationValidation validates at plan time that a string attribute is a non-negative duration
+// in the format of Go durations, for example "24h", "1h30m" or "86400s"
+var DurationValidation schema.SchemaValidateDiagFunc = func(value interface{}, path cty.Path) diag.Diagnostics {
+	str, ok := value.(string)
+	if !ok {
+		return diag.Errorf("value is no string")
+	}
+	duration, err := time.ParseDuration(str)
+	if err != nil {
+		return diag.Diagnostics{{Severity: diag.Error, Summary: fmt.Sprintf(`invalid duration "%s", expected a duration like "24h", "1h30m" or "86400s"`, str), AttributePath: path}}
+	}
+	if duration < 0 {
+		return diag.Diagnostics{{Severity: diag.Error, Summary: fmt.Sprintf(`duration "%s" must not be negative`, str), AttributePath: path}}
+	}
+	return nil
+}
+
+// SuppressEquivalentDurations suppresses the diff between durations with different notations but the same value,
+// for example between "24h", "24h0m0s" and "86400s"
+var SuppressEquivalentDurations schema.SchemaDiffSuppressFunc = func(_, old, new string, _ *schema.ResourceData) bool {
+	oldDuration, err := time.ParseDuration(old)
+	if err != nil {
+		return false
+	}
+	newDuration, err := time.ParseDuration(new)
+	if err != nil {
+		return false
+	}
+	return oldDuration == newDuration
+}
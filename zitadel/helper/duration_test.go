@@ -0,0 +1,54 @@
+package helper
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+)
+
+func TestDurationValidation(t *testing.T) {
+	tests := []struct {
+		value     string
+		expectErr bool
+	}{
+		{value: "24h"},
+		{value: "1h30m"},
+		{value: "86400s"},
+		{value: "0s"},
+		{value: "0"},
+		{value: "", expectErr: true},
+		{value: "1d", expectErr: true},
+		{value: "24", expectErr: true},
+		{value: "-1h", expectErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			diags := DurationValidation(tt.value, cty.Path{})
+			if diags.HasError() != tt.expectErr {
+				t.Errorf("DurationValidation(%s) error = %v, expect error %t", tt.value, diags, tt.expectErr)
+			}
+		})
+	}
+}
+
+func TestSuppressEquivalentDurations(t *testing.T) {
+	tests := []struct {
+		old, new string
+		suppress bool
+	}{
+		{old: "24h0m0s", new: "24h", suppress: true},
+		{old: "24h0m0s", new: "86400s", suppress: true},
+		{old: "1h30m0s", new: "90m", suppress: true},
+		{old: "0s", new: "0", suppress: true},
+		{old: "24h0m0s", new: "12h", suppress: false},
+		{old: "", new: "24h", suppress: false},
+		{old: "24h0m0s", new: "1d", suppress: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.old+"/"+tt.new, func(t *testing.T) {
+			if got := SuppressEquivalentDurations("", tt.old, tt.new, nil); got != tt.suppress {
+				t.Errorf("SuppressEquivalentDurations(%s, %s) = %t, want %t", tt.old, tt.new, got, tt.suppress)
+			}
+		})
+	}
+}
@@ -26,23 +26,14 @@ func escapeQuotes(s string) string {
 	return quoteEscaper.Replace(s)
 }
 
-func createMultipartRequest(issuer, endpoint, path string) (*http.Request, error) {
-	file, err := os.Open(path)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read file: %v", err)
-	}
-
+func createMultipartRequest(issuer, endpoint, filename string, data []byte) (*http.Request, error) {
 	body := &bytes.Buffer{}
 	writer := multipart.NewWriter(body)
-	data, err := io.ReadAll(file)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read asset: %v", err)
-	}
 
 	h := make(textproto.MIMEHeader)
 	h.Set("Content-Disposition",
 		fmt.Sprintf(`form-data; name="%s"; filename="%s"`,
-			escapeQuotes("file"), escapeQuotes(filepath.Base(file.Name()))))
+			escapeQuotes("file"), escapeQuotes(filename)))
 	h.Set("Content-Type", mimetype.Detect(data).String())
 	part, err := writer.CreatePart(h)
 	if err != nil {
@@ -60,17 +51,40 @@ func createMultipartRequest(issuer, endpoint, path string) (*http.Request, error
 	return r, nil
 }
 
+func readFile(path string) (string, []byte, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to read file: %v", err)
+	}
+	return filepath.Base(path), data, nil
+}
+
 func InstanceFormFilePost(ctx context.Context, clientInfo *ClientInfo, endpoint, path string) diag.Diagnostics {
-	return formFilePost(ctx, clientInfo, endpoint, path, map[string]string{})
+	filename, data, err := readFile(path)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return InstanceFormContentPost(ctx, clientInfo, endpoint, filename, data)
 }
 
 func OrgFormFilePost(ctx context.Context, clientInfo *ClientInfo, endpoint, path, orgID string) diag.Diagnostics {
-	return formFilePost(ctx, clientInfo, endpoint, path, map[string]string{"x-zitadel-orgid": orgID})
+	filename, data, err := readFile(path)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return OrgFormContentPost(ctx, clientInfo, endpoint, filename, data, orgID)
+}
+
+func InstanceFormContentPost(ctx context.Context, clientInfo *ClientInfo, endpoint, filename string, data []byte) diag.Diagnostics {
+	return formContentPost(ctx, clientInfo, endpoint, filename, data, map[string]string{})
 }
 
-func formFilePost(ctx context.Context, clientInfo *ClientInfo, endpoint, path string, additionalHeaders map[string]string) diag.Diagnostics {
-	var client *http.Client
-	r, err := createMultipartRequest(clientInfo.Issuer, endpoint, path)
+func OrgFormContentPost(ctx context.Context, clientInfo *ClientInfo, endpoint, filename string, data []byte, orgID string) diag.Diagnostics {
+	return formContentPost(ctx, clientInfo, endpoint, filename, data, map[string]string{"x-zitadel-orgid": orgID})
+}
+
+func formContentPost(ctx context.Context, clientInfo *ClientInfo, endpoint, filename string, data []byte, additionalHeaders map[string]string) diag.Diagnostics {
+	r, err := createMultipartRequest(clientInfo.Issuer, endpoint, filename, data)
 	if err != nil {
 		return diag.Errorf("failed to create asset request: %v", err)
 	}
@@ -78,18 +92,9 @@ func formFilePost(ctx context.Context, clientInfo *ClientInfo, endpoint, path st
 		r.Header.Add(k, v)
 	}
 
-	if clientInfo.KeyPath != "" {
-		client, err = NewClientWithInterceptorFromKeyFile(ctx, clientInfo.Issuer, clientInfo.KeyPath, []string{oidc.ScopeOpenID, zitadel.ScopeZitadelAPI()})
-		if err != nil {
-			return diag.Errorf("failed to create client: %v", err)
-		}
-	} else if len(clientInfo.Data) > 0 {
-		client, err = NewClientWithInterceptorFromKeyFileData(ctx, clientInfo.Issuer, clientInfo.Data, []string{oidc.ScopeOpenID, zitadel.ScopeZitadelAPI()})
-		if err != nil {
-			return diag.Errorf("failed to create client: %v", err)
-		}
-	} else {
-		return diag.Errorf("either 'jwt_profile_file' or 'jwt_profile_json' is required")
+	client, err := newInterceptorClient(ctx, clientInfo)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	resp, err := client.Do(r)
@@ -99,6 +104,48 @@ func formFilePost(ctx context.Context, clientInfo *ClientInfo, endpoint, path st
 	return nil
 }
 
+// GetAsset downloads an asset served by ZITADEL, relative URLs are resolved against the issuer
+func GetAsset(ctx context.Context, clientInfo *ClientInfo, assetURL string) ([]byte, error) {
+	if !strings.HasPrefix(assetURL, "http://") && !strings.HasPrefix(assetURL, "https://") {
+		assetURL = strings.TrimSuffix(clientInfo.Issuer, "/") + "/" + strings.TrimPrefix(assetURL, "/")
+	}
+	r, err := http.NewRequestWithContext(ctx, http.MethodGet, assetURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create asset request: %v", err)
+	}
+	client, err := newInterceptorClient(ctx, clientInfo)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Do(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to do asset request: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to do asset request: unexpected status %s", resp.Status)
+	}
+	return io.ReadAll(resp.Body)
+}
+
+func newInterceptorClient(ctx context.Context, clientInfo *ClientInfo) (*http.Client, error) {
+	if clientInfo.KeyPath != "" {
+		client, err := NewClientWithInterceptorFromKeyFile(ctx, clientInfo.Issuer, clientInfo.KeyPath, []string{oidc.ScopeOpenID, zitadel.ScopeZitadelAPI()})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create client: %v", err)
+		}
+		return client, nil
+	}
+	if len(clientInfo.Data) > 0 {
+		client, err := NewClientWithInterceptorFromKeyFileData(ctx, clientInfo.Issuer, clientInfo.Data, []string{oidc.ScopeOpenID, zitadel.ScopeZitadelAPI()})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create client: %v", err)
+		}
+		return client, nil
+	}
+	return nil, fmt.Errorf("either 'jwt_profile_file' or 'jwt_profile_json' is required")
+}
+
 type Interceptor struct {
 	tokenSource oauth2.TokenSource
 	core        http.RoundTripper
@@ -128,7 +175,9 @@ func NewClientWithInterceptorFromKeyFileData(ctx context.Context, issuer string,
 
 func (i Interceptor) RoundTrip(r *http.Request) (*http.Response, error) {
 	defer func() {
-		_ = r.Body.Close()
+		if r.Body != nil {
+			_ = r.Body.Close()
+		}
 	}()
 
 	ts := oauth2.ReuseTokenSource(nil, i.tokenSource)
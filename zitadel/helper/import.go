@@ -16,7 +16,7 @@ import (
 )
 
 var (
-	ImportOptionalOrgAttribute = NewImportAttribute(OrgIDVar, ConvertID, true)
+	ImportOptionalOrgAttribute = NewImportAttribute(OrgIDVar, ConvertOrgID, true)
 	emptyIDAttribute           = NewImportAttribute(`""`, ConvertEmpty, false)
 	SemicolonPlaceholder       = "__SEMICOLON__"
 )
@@ -92,7 +92,18 @@ func (i ImportAttributes) Swap(j, k int) { (i)[j], (i)[k] = (i)[k], (i)[j] }
 
 func ImportWithAttributes(attrs ...importAttribute) *schema.ResourceImporter {
 	return &schema.ResourceImporter{
-		StateContext: func(_ context.Context, data *schema.ResourceData, i interface{}) (ret []*schema.ResourceData, err error) {
+		StateContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) (ret []*schema.ResourceData, err error) {
+			if segments, ok := parseImportPath(data.Id()); ok {
+				clientinfo, ok := i.(*ClientInfo)
+				if !ok {
+					return nil, errors.New("failed to get client")
+				}
+				id, err := resolveImportPath(ctx, resolveImportPathSegment(clientinfo), segments, attrs...)
+				if err != nil {
+					return nil, fmt.Errorf(`failed to resolve import path "%s": %w`, data.Id(), err)
+				}
+				data.SetId(id)
+			}
 			return []*schema.ResourceData{data}, importWithAttributes(data, attrs...)
 		},
 	}
@@ -179,6 +190,16 @@ func ConvertID(id string) (interface{}, error) {
 	return id, nil
 }
 
+var _ ConvertStringFunc = ConvertOrgID
+
+// ConvertOrgID accepts generated IDs as well as the custom IDs organizations can be created with
+func ConvertOrgID(id string) (interface{}, error) {
+	if !ZitadelCustomIdOnlyRegex.MatchString(id) {
+		return nil, fmt.Errorf(`id "%s" does not match regular expression %s`, id, ZitadelCustomIdOnlyRegex.String())
+	}
+	return id, nil
+}
+
 var _ ConvertStringFunc = ConvertJSON
 
 func ConvertJSON(importValue string) (interface{}, error) {
@@ -0,0 +1,264 @@
+package helper
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/action"
+	adminpb "github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/admin"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/app"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/idp"
+	managementpb "github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/management"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/object"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/org"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/project"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/user"
+)
+
+const (
+	importPathOrg     = "org"
+	importPathProject = "project"
+	importPathApp     = "app"
+	importPathUser    = "user"
+	importPathAction  = "action"
+	importPathIDP     = "idp"
+	importPathRole    = "role"
+)
+
+// importPathKeys maps the kinds of an import path to the import attribute keys their IDs are set to
+var importPathKeys = map[string]string{
+	importPathOrg:     OrgIDVar,
+	importPathProject: "project_id",
+	importPathApp:     "app_id",
+	importPathUser:    "user_id",
+	importPathAction:  "action_id",
+	importPathIDP:     "idp_id",
+	importPathRole:    "role_key",
+}
+
+// importPathParents maps the kinds of an import path to the kinds they are allowed to follow,
+// an empty string means the kind is allowed at the beginning of the path
+var importPathParents = map[string][]string{
+	importPathOrg:     {""},
+	importPathProject: {importPathOrg},
+	importPathApp:     {importPathProject},
+	importPathUser:    {importPathOrg, importPathProject},
+	importPathAction:  {importPathOrg},
+	importPathIDP:     {"", importPathOrg},
+	importPathRole:    {importPathProject},
+}
+
+type importPathSegment struct {
+	kind string
+	name string
+}
+
+// importPathResolver returns the ID of the object of the given kind and name.
+// The IDs of the preceding path segments are passed by their import attribute keys.
+type importPathResolver func(ctx context.Context, kind, name string, resolved map[string]string) (string, error)
+
+// parseImportPath splits an import ID like org/<name-or-id>/project/<name-or-id>/app/<name-or-id> into its segments.
+// It returns false if the ID is no import path, so it is parsed as positional import ID.
+func parseImportPath(id string) ([]importPathSegment, bool) {
+	parts := strings.Split(id, "/")
+	if len(parts) < 2 || len(parts)%2 != 0 {
+		return nil, false
+	}
+	segments := make([]importPathSegment, 0, len(parts)/2)
+	parent := ""
+	for i := 0; i < len(parts); i += 2 {
+		kind, name := parts[i], parts[i+1]
+		parents, ok := importPathParents[kind]
+		if !ok || name == "" || !slices.Contains(parents, parent) {
+			return nil, false
+		}
+		segments = append(segments, importPathSegment{kind: kind, name: name})
+		parent = kind
+	}
+	return segments, true
+}
+
+// resolveImportPath resolves the segments of an import path and returns the positional import ID for the given attributes
+func resolveImportPath(ctx context.Context, resolve importPathResolver, segments []importPathSegment, attrs ...importAttribute) (string, error) {
+	resolved := make(map[string]string, len(segments))
+	for _, segment := range segments {
+		id, err := resolve(ctx, segment.kind, segment.name, resolved)
+		if err != nil {
+			return "", err
+		}
+		resolved[importPathKeys[segment.kind]] = id
+	}
+	sorted := make([]importAttribute, len(attrs))
+	copy(sorted, attrs)
+	sort.Sort(ImportAttributes(sorted))
+	keys := make(map[string]bool, len(sorted))
+	for _, attr := range sorted {
+		keys[attr.key] = true
+	}
+	// the resource ID is the last segment if its kind is not imported by another key, for example the id of an org or idp
+	leafKey := importPathKeys[segments[len(segments)-1].kind]
+	parts := make([]string, 0, len(sorted))
+	for i, attr := range sorted {
+		if i == 0 && attr.key == emptyIDAttribute.key {
+			continue
+		}
+		value, ok := resolved[attr.key]
+		if !ok && i == 0 && !keys[leafKey] {
+			value, ok = resolved[leafKey], true
+		}
+		if !ok && !attr.optional {
+			return "", fmt.Errorf("%s can't be resolved from the import path, use the colon separated import ID format instead", attr.key)
+		}
+		parts = append(parts, strings.ReplaceAll(value, ":", SemicolonPlaceholder))
+	}
+	for len(parts) > 0 && parts[len(parts)-1] == "" {
+		parts = parts[:len(parts)-1]
+	}
+	return strings.Join(parts, ":"), nil
+}
+
+// resolveImportPathSegment queries the ZITADEL API for the object of the given kind by its exact name.
+// Values that look like ZITADEL IDs are returned unchanged.
+// As orgs can have custom IDs, an org is looked up by its ID before it is queried by its name.
+func resolveImportPathSegment(clientInfo *ClientInfo) importPathResolver {
+	return func(ctx context.Context, kind, name string, resolved map[string]string) (string, error) {
+		if kind == importPathRole || (kind != importPathOrg && ZitadelGeneratedIdOnlyRegex.MatchString(name)) {
+			return name, nil
+		}
+		orgID, isOrgScoped := resolved[OrgIDVar]
+		ctx = CtxSetOrgID(ctx, orgID)
+		var ids []string
+		switch kind {
+		case importPathOrg:
+			client, err := GetAdminClient(ctx, clientInfo)
+			if err != nil {
+				return "", err
+			}
+			if ZitadelCustomIdOnlyRegex.MatchString(name) {
+				_, err := client.GetOrgByID(ctx, &adminpb.GetOrgByIDRequest{Id: name})
+				if err == nil {
+					return name, nil
+				}
+				if IgnoreIfNotFoundError(err) != nil {
+					return "", fmt.Errorf("failed to get org: %w", err)
+				}
+			}
+			resp, err := client.ListOrgs(ctx, &adminpb.ListOrgsRequest{
+				Queries: []*org.OrgQuery{{Query: &org.OrgQuery_NameQuery{NameQuery: &org.OrgNameQuery{Name: name, Method: object.TextQueryMethod_TEXT_QUERY_METHOD_EQUALS}}}},
+			})
+			if err != nil {
+				return "", fmt.Errorf("failed to list orgs: %w", err)
+			}
+			for _, result := range resp.GetResult() {
+				ids = append(ids, result.GetId())
+			}
+		case importPathProject:
+			client, err := GetManagementClient(ctx, clientInfo)
+			if err != nil {
+				return "", err
+			}
+			resp, err := client.ListProjects(ctx, &managementpb.ListProjectsRequest{
+				Queries: []*project.ProjectQuery{{Query: &project.ProjectQuery_NameQuery{NameQuery: &project.ProjectNameQuery{Name: name, Method: object.TextQueryMethod_TEXT_QUERY_METHOD_EQUALS}}}},
+			})
+			if err != nil {
+				return "", fmt.Errorf("failed to list projects: %w", err)
+			}
+			for _, result := range resp.GetResult() {
+				ids = append(ids, result.GetId())
+			}
+		case importPathApp:
+			client, err := GetManagementClient(ctx, clientInfo)
+			if err != nil {
+				return "", err
+			}
+			resp, err := client.ListApps(ctx, &managementpb.ListAppsRequest{
+				ProjectId: resolved[importPathKeys[importPathProject]],
+				Queries:   []*app.AppQuery{{Query: &app.AppQuery_NameQuery{NameQuery: &app.AppNameQuery{Name: name, Method: object.TextQueryMethod_TEXT_QUERY_METHOD_EQUALS}}}},
+			})
+			if err != nil {
+				return "", fmt.Errorf("failed to list apps: %w", err)
+			}
+			for _, result := range resp.GetResult() {
+				ids = append(ids, result.GetId())
+			}
+		case importPathUser:
+			client, err := GetManagementClient(ctx, clientInfo)
+			if err != nil {
+				return "", err
+			}
+			resp, err := client.ListUsers(ctx, &managementpb.ListUsersRequest{
+				Queries: []*user.SearchQuery{{Query: &user.SearchQuery_UserNameQuery{UserNameQuery: &user.UserNameQuery{UserName: name, Method: object.TextQueryMethod_TEXT_QUERY_METHOD_EQUALS}}}},
+			})
+			if err != nil {
+				return "", fmt.Errorf("failed to list users: %w", err)
+			}
+			for _, result := range resp.GetResult() {
+				ids = append(ids, result.GetId())
+			}
+		case importPathAction:
+			client, err := GetManagementClient(ctx, clientInfo)
+			if err != nil {
+				return "", err
+			}
+			resp, err := client.ListActions(ctx, &managementpb.ListActionsRequest{
+				Queries: []*managementpb.ActionQuery{{Query: &managementpb.ActionQuery_ActionNameQuery{ActionNameQuery: &action.ActionNameQuery{Name: name, Method: object.TextQueryMethod_TEXT_QUERY_METHOD_EQUALS}}}},
+			})
+			if err != nil {
+				return "", fmt.Errorf("failed to list actions: %w", err)
+			}
+			for _, result := range resp.GetResult() {
+				ids = append(ids, result.GetId())
+			}
+		case importPathIDP:
+			nameQuery := &idp.IDPNameQuery{Name: name, Method: object.TextQueryMethod_TEXT_QUERY_METHOD_EQUALS}
+			var providers []*idp.Provider
+			if isOrgScoped {
+				client, err := GetManagementClient(ctx, clientInfo)
+				if err != nil {
+					return "", err
+				}
+				resp, err := client.ListProviders(ctx, &managementpb.ListProvidersRequest{
+					Queries: []*managementpb.ProviderQuery{{Query: &managementpb.ProviderQuery_IdpNameQuery{IdpNameQuery: nameQuery}}},
+				})
+				if err != nil {
+					return "", fmt.Errorf("failed to list identity providers: %w", err)
+				}
+				providers = resp.GetResult()
+			} else {
+				client, err := GetAdminClient(ctx, clientInfo)
+				if err != nil {
+					return "", err
+				}
+				resp, err := client.ListProviders(ctx, &adminpb.ListProvidersRequest{
+					Queries: []*adminpb.ProviderQuery{{Query: &adminpb.ProviderQuery_IdpNameQuery{IdpNameQuery: nameQuery}}},
+				})
+				if err != nil {
+					return "", fmt.Errorf("failed to list identity providers: %w", err)
+				}
+				providers = resp.GetResult()
+			}
+			expectOwner := idp.IDPOwnerType_IDP_OWNER_TYPE_SYSTEM
+			if isOrgScoped {
+				expectOwner = idp.IDPOwnerType_IDP_OWNER_TYPE_ORG
+			}
+			for _, provider := range providers {
+				if provider.GetOwner() == expectOwner {
+					ids = append(ids, provider.GetId())
+				}
+			}
+		default:
+			return "", fmt.Errorf("unknown import path kind %s", kind)
+		}
+		switch len(ids) {
+		case 0:
+			return "", fmt.Errorf(`no %s with the name "%s" found`, kind, name)
+		case 1:
+			return ids[0], nil
+		default:
+			return "", fmt.Errorf(`%d objects of kind %s with the name "%s" found, use the ID instead`, len(ids), kind, name)
+		}
+	}
+}
@@ -0,0 +1,154 @@
+package helper
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestResolveImportPath(t *testing.T) {
+	ids := map[string]string{
+		"org/my-org":         "100000000000000001",
+		"project/my-project": "100000000000000002",
+		"app/my-app":         "100000000000000003",
+		"user/jane":          "100000000000000004",
+		"idp/google":         "100000000000000005",
+	}
+	orgIDs := map[string]bool{
+		"123456789012345678": true,
+		"tenant-production":  true,
+	}
+	resolve := func(_ context.Context, kind, name string, _ map[string]string) (string, error) {
+		if kind == importPathRole || (kind != importPathOrg && ZitadelGeneratedIdOnlyRegex.MatchString(name)) {
+			return name, nil
+		}
+		if kind == importPathOrg && orgIDs[name] {
+			return name, nil
+		}
+		id, ok := ids[kind+"/"+name]
+		if !ok {
+			return "", fmt.Errorf(`no %s with the name "%s" found`, kind, name)
+		}
+		return id, nil
+	}
+	secretAttribute := NewImportAttribute("secret", ConvertNonEmpty, true)
+	tests := []struct {
+		name        string
+		path        string
+		attrs       []importAttribute
+		expectID    string
+		expectError string
+	}{{
+		name:     "org by name",
+		path:     "org/my-org",
+		attrs:    []importAttribute{NewImportAttribute("id", ConvertID, false)},
+		expectID: "100000000000000001",
+	}, {
+		name:     "org by id",
+		path:     "org/123456789012345678",
+		attrs:    []importAttribute{NewImportAttribute("id", ConvertID, false)},
+		expectID: "123456789012345678",
+	}, {
+		name:     "org by custom id",
+		path:     "org/tenant-production",
+		attrs:    []importAttribute{NewImportAttribute("id", ConvertOrgID, false)},
+		expectID: "tenant-production",
+	}, {
+		name:     "project",
+		path:     "org/my-org/project/my-project",
+		attrs:    []importAttribute{NewImportAttribute("project_id", ConvertID, false), ImportOptionalOrgAttribute},
+		expectID: "100000000000000002:100000000000000001",
+	}, {
+		name: "app with omitted optional secrets",
+		path: "org/my-org/project/my-project/app/my-app",
+		attrs: []importAttribute{
+			NewImportAttribute("app_id", ConvertID, false),
+			NewImportAttribute("project_id", ConvertID, false),
+			ImportOptionalOrgAttribute,
+			secretAttribute,
+		},
+		expectID: "100000000000000003:100000000000000002:100000000000000001",
+	}, {
+		name: "project role with empty id",
+		path: "org/my-org/project/my-project/role/key:with:colons",
+		attrs: []importAttribute{
+			emptyIDAttribute,
+			NewImportAttribute("project_id", ConvertID, false),
+			NewImportAttribute("role_key", ConvertNonEmpty, false),
+			ImportOptionalOrgAttribute,
+		},
+		expectID: "100000000000000002:key__SEMICOLON__with__SEMICOLON__colons:100000000000000001",
+	}, {
+		name: "project member",
+		path: "org/my-org/project/my-project/user/jane",
+		attrs: []importAttribute{
+			emptyIDAttribute,
+			NewImportAttribute("project_id", ConvertID, false),
+			NewImportAttribute("user_id", ConvertID, false),
+			ImportOptionalOrgAttribute,
+		},
+		expectID: "100000000000000002:100000000000000004:100000000000000001",
+	}, {
+		name:     "instance idp",
+		path:     "idp/google",
+		attrs:    []importAttribute{NewImportAttribute("id", ConvertID, false), secretAttribute},
+		expectID: "100000000000000005",
+	}, {
+		name:     "org idp",
+		path:     "org/my-org/idp/google",
+		attrs:    []importAttribute{NewImportAttribute("id", ConvertID, false), ImportOptionalOrgAttribute, secretAttribute},
+		expectID: "100000000000000005:100000000000000001",
+	}, {
+		name: "project grant id can't be resolved",
+		path: "org/my-org/project/my-project",
+		attrs: []importAttribute{
+			NewImportAttribute("grant_id", ConvertID, false),
+			NewImportAttribute("project_id", ConvertID, false),
+			ImportOptionalOrgAttribute,
+		},
+		expectError: "grant_id can't be resolved from the import path",
+	}, {
+		name:        "unknown name",
+		path:        "org/unknown",
+		attrs:       []importAttribute{NewImportAttribute("id", ConvertID, false)},
+		expectError: `no org with the name "unknown" found`,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			segments, ok := parseImportPath(tt.path)
+			if !ok {
+				t.Fatalf("parseImportPath(%s) expected to be an import path", tt.path)
+			}
+			id, err := resolveImportPath(context.Background(), resolve, segments, tt.attrs...)
+			if tt.expectError != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.expectError) {
+					t.Fatalf(`resolveImportPath() expected error containing "%s", got: %v`, tt.expectError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("resolveImportPath() unexpected error: %v", err)
+			}
+			if id != tt.expectID {
+				t.Errorf("resolveImportPath() = %s, want %s", id, tt.expectID)
+			}
+		})
+	}
+}
+
+func TestParseImportPathIgnoresPositionalIDs(t *testing.T) {
+	for _, id := range []string{
+		"123456789012345678",
+		"123456789012345678:123456789012345678",
+		"123456789012345678:true:123456789012345678:client/id:c2VjcmV0/Zm9v",
+		"org/",
+		"project/my-project",
+		"org/my-org/app/my-app",
+		"org/my-org/project",
+	} {
+		if _, ok := parseImportPath(id); ok {
+			t.Errorf("parseImportPath(%s) expected to be no import path", id)
+		}
+	}
+}
@@ -153,6 +153,17 @@ func TestImportWithAttributes(t *testing.T) {
 				"id": validID,
 			},
 		},
+	}, {
+		name: `<[org_id]> with 'tenant-production' works`,
+		args: args{
+			attrs: []importAttribute{ImportOptionalOrgAttribute},
+			id:    "tenant-production",
+		},
+		want: want{
+			attributes: map[string]interface{}{
+				"id": "tenant-production",
+			},
+		},
 	}, {
 		name: `<[org_id]> with '' works`,
 		args: args{
@@ -19,13 +19,18 @@ var (
 	ZitadelGeneratedIdPattern   = `\d{18}`
 	ZitadelGeneratedIdOnlyRegex = regexp.MustCompile(fmt.Sprintf(`^%s$`, ZitadelGeneratedIdPattern))
 
+	// ZitadelCustomIdPattern matches IDs like tenant-production
+	// Organizations can be created with custom IDs, which also includes the generated ones
+	ZitadelCustomIdPattern   = `[a-zA-Z0-9_-]{1,200}`
+	ZitadelCustomIdOnlyRegex = regexp.MustCompile(fmt.Sprintf(`^%s$`, ZitadelCustomIdPattern))
+
 	OrgIDResourceField = &schema.Schema{
 		Type:        schema.TypeString,
 		Optional:    true,
 		Description: "ID of the organization",
 		ForceNew:    true,
 		ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
-			_, err := ConvertID(i.(string))
+			_, err := ConvertOrgID(i.(string))
 			return diag.FromErr(err)
 		},
 	}
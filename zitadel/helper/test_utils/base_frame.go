@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
+	"github.com/zitadel/terraform-provider-zitadel/v2/acceptance"
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel"
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
 )
@@ -33,12 +34,15 @@ type BaseTestFrame struct {
 }
 
 func NewBaseTestFrame(ctx context.Context, resourceType, domain string, jwtProfileJson []byte) (*BaseTestFrame, error) {
+	cfg := acceptance.GetConfig()
 	zitadelProvider := zitadel.Provider()
 	diag := zitadelProvider.Configure(ctx, terraform.NewResourceConfigRaw(map[string]interface{}{
 		"domain":           domain,
 		"insecure":         insecure,
 		"port":             port,
 		"jwt_profile_json": string(jwtProfileJson),
+		"system_api_user":  cfg.SystemAPIUser,
+		"system_api_key":   string(cfg.SystemAPIKey),
 	}))
 	if diag.HasError() {
 		return nil, fmt.Errorf("unknown error configuring the test provider: %v", diag)
@@ -50,9 +54,13 @@ provider "zitadel" {
   port     			= "%s" 
   jwt_profile_json  = <<KEY
 %s
+KEY
+  system_api_user   = "%s"
+  system_api_key    = <<KEY
+%s
 KEY
 }
-`, domain, insecure, port, string(jwtProfileJson))
+`, domain, insecure, port, string(jwtProfileJson), cfg.SystemAPIUser, string(cfg.SystemAPIKey))
 	clientInfo := zitadelProvider.Meta().(*helper.ClientInfo)
 	uniqueID := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
 	terraformName := fmt.Sprintf("%s.default", resourceType)
@@ -88,6 +96,11 @@ KEY
 	return frame, nil
 }
 
+// ProtoV6ProviderFactories returns the provider factories for tests with custom steps
+func (b *BaseTestFrame) ProtoV6ProviderFactories() map[string]func() (tfprotov6.ProviderServer, error) {
+	return b.v6ProviderFactories
+}
+
 func (b *BaseTestFrame) State(state *terraform.State) *terraform.InstanceState {
 	resources := state.RootModule().Resources
 	resource := resources[b.TerraformName]
@@ -13,7 +13,7 @@ import (
 )
 
 func GetResource() *schema.Resource {
-	return &schema.Resource{
+	return helper.WithDeletionProtection(&schema.Resource{
 		Description: "Resource representing a human user situated under an organization, which then can be authorized through memberships or direct grants on other resources.",
 		Schema: map[string]*schema.Schema{
 			helper.OrgIDVar: helper.OrgIDResourceField,
@@ -142,5 +142,5 @@ func GetResource() *schema.Resource {
 			}),
 		),
 		Importer: helper.ImportWithIDAndOptionalOrgAndSecret(UserIDVar, InitialPasswordVar),
-	}
+	}, false)
 }
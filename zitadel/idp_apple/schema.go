@@ -0,0 +1,56 @@
+package idp_apple
+
+import (
+	"encoding/pem"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	TeamIDVar     = "team_id"
+	KeyIDVar      = "key_id"
+	PrivateKeyVar = "private_key"
+)
+
+var (
+	TeamIDResourceField = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "the team id of the Apple developer account",
+	}
+	TeamIDDataSourceField = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "the team id of the Apple developer account",
+	}
+	KeyIDResourceField = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "the id of the private key generated by Apple",
+	}
+	KeyIDDataSourceField = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "the id of the private key generated by Apple",
+	}
+	PrivateKeyResourceField = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Sensitive:   true,
+		Description: "the content of the private key file (.p8) generated by Apple, for example read with the file function",
+		ValidateDiagFunc: func(value interface{}, path cty.Path) diag.Diagnostics {
+			block, _ := pem.Decode([]byte(value.(string)))
+			if block == nil || block.Type != "PRIVATE KEY" {
+				return diag.Errorf("%s must be a PEM encoded private key file", PrivateKeyVar)
+			}
+			return nil
+		},
+	}
+)
+
+// PrivateKeyValue returns the private key as it is sent to ZITADEL
+func PrivateKeyValue(d *schema.ResourceData) []byte {
+	return []byte(d.Get(PrivateKeyVar).(string))
+}
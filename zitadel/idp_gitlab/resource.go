@@ -8,7 +8,7 @@ import (
 )
 
 func GetResource() *schema.Resource {
-	return &schema.Resource{
+	return helper.WithDeletionProtection(&schema.Resource{
 		Description: "Resource representing a GitLab IDP on the instance.",
 		Schema: map[string]*schema.Schema{
 			idp_utils.NameVar:              idp_utils.NameResourceField,
@@ -26,5 +26,5 @@ func GetResource() *schema.Resource {
 		CreateContext: create,
 		DeleteContext: idp_utils.Delete,
 		Importer:      helper.ImportWithIDAndOptionalSecret(idp_utils.IdpIDVar, idp_utils.ClientSecretVar),
-	}
+	}, false)
 }
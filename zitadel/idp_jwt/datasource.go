@@ -0,0 +1,27 @@
+package idp_jwt
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/idp_utils"
+)
+
+func GetDatasource() *schema.Resource {
+	return &schema.Resource{
+		Description: "Datasource representing a generic JWT IDP on the instance.",
+		Schema: map[string]*schema.Schema{
+			idp_utils.IdpIDVar:             idp_utils.IdPIDDataSourceField,
+			idp_utils.NameVar:              idp_utils.NameDataSourceField,
+			IssuerVar:                      IssuerDataSourceField,
+			JWTEndpointVar:                 JWTEndpointDataSourceField,
+			KeysEndpointVar:                KeysEndpointDataSourceField,
+			HeaderNameVar:                  HeaderNameDataSourceField,
+			idp_utils.IsLinkingAllowedVar:  idp_utils.IsLinkingAllowedDataSourceField,
+			idp_utils.IsCreationAllowedVar: idp_utils.IsCreationAllowedDataSourceField,
+			idp_utils.IsAutoCreationVar:    idp_utils.IsAutoCreationDataSourceField,
+			idp_utils.IsAutoUpdateVar:      idp_utils.IsAutoUpdateDataSourceField,
+			idp_utils.AutoLinkingVar:       idp_utils.AutoLinkingDataSourceField,
+		},
+		ReadContext: read,
+	}
+}
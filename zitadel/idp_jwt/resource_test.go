@@ -0,0 +1,11 @@
+package idp_jwt_test
+
+import (
+	"testing"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/idp_utils/idp_test_utils"
+)
+
+func TestAccInstanceIdPJWT(t *testing.T) {
+	idp_test_utils.RunInstanceIDPLifecyleTest(t, "zitadel_idp_jwt", "")
+}
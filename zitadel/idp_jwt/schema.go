@@ -0,0 +1,53 @@
+package idp_jwt
+
+import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+const (
+	IssuerVar       = "issuer"
+	JWTEndpointVar  = "jwt_endpoint"
+	KeysEndpointVar = "keys_endpoint"
+	HeaderNameVar   = "header_name"
+)
+
+var (
+	IssuerResourceField = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "the issuer of the jwt (for validation)",
+	}
+	IssuerDataSourceField = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "the issuer of the jwt (for validation)",
+	}
+	JWTEndpointResourceField = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "the endpoint where the jwt can be extracted",
+	}
+	JWTEndpointDataSourceField = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "the endpoint where the jwt can be extracted",
+	}
+	KeysEndpointResourceField = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "the endpoint to the key (JWK) which are used to sign the JWT with",
+	}
+	KeysEndpointDataSourceField = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "the endpoint to the key (JWK) which are used to sign the JWT with",
+	}
+	HeaderNameResourceField = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "the name of the header where the JWT is sent in, default is authorization",
+	}
+	HeaderNameDataSourceField = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "the name of the header where the JWT is sent in, default is authorization",
+	}
+)
@@ -21,7 +21,7 @@ func GetDatasource() *schema.Resource {
 			idp_utils.IsAutoUpdateVar:      idp_utils.IsAutoUpdateDataSourceField,
 			idp_utils.AutoLinkingVar:       idp_utils.AutoLinkingDataSourceField,
 			IssuerVar:                      IssuerDatasourceField,
-			IsIdTokenMappingVar:            IsIdTokenMappingDatasourceField,
+			idp_utils.IsIdTokenMappingVar:  idp_utils.IsIdTokenMappingDataSourceField,
 		},
 		ReadContext: read,
 	}
@@ -8,7 +8,7 @@ import (
 )
 
 func GetResource() *schema.Resource {
-	return &schema.Resource{
+	return helper.WithDeletionProtection(&schema.Resource{
 		Description: "Resource representing a generic OIDC IDP on the instance.",
 		Schema: map[string]*schema.Schema{
 			idp_utils.NameVar:              idp_utils.NameResourceField,
@@ -21,12 +21,12 @@ func GetResource() *schema.Resource {
 			idp_utils.IsAutoUpdateVar:      idp_utils.IsAutoUpdateResourceField,
 			idp_utils.AutoLinkingVar:       idp_utils.AutoLinkingResourceField,
 			IssuerVar:                      IssuerResourceField,
-			IsIdTokenMappingVar:            IsIdTokenMappingResourceField,
+			idp_utils.IsIdTokenMappingVar:  idp_utils.IsIdTokenMappingResourceField,
 		},
 		ReadContext:   read,
 		UpdateContext: update,
 		CreateContext: create,
 		DeleteContext: idp_utils.Delete,
 		Importer:      helper.ImportWithIDAndOptionalSecret(idp_utils.IdpIDVar, idp_utils.ClientSecretVar),
-	}
+	}, false)
 }
@@ -10,16 +10,18 @@ func GetDatasource() *schema.Resource {
 	return &schema.Resource{
 		Description: "Datasource representing a SAML IDP on the instance.",
 		Schema: map[string]*schema.Schema{
-			idp_utils.IdpIDVar:             idp_utils.IdPIDDataSourceField,
-			idp_utils.NameVar:              idp_utils.NameDataSourceField,
-			BindingVar:                     BindingDatasourceField,
-			MetadataXMLVar:                 MetadataXMLDatasourceField,
-			WithSignedRequestVar:           WithSignedRequestDatasourceField,
-			idp_utils.IsLinkingAllowedVar:  idp_utils.IsLinkingAllowedDataSourceField,
-			idp_utils.IsCreationAllowedVar: idp_utils.IsCreationAllowedDataSourceField,
-			idp_utils.IsAutoCreationVar:    idp_utils.IsAutoCreationDataSourceField,
-			idp_utils.IsAutoUpdateVar:      idp_utils.IsAutoUpdateDataSourceField,
-			idp_utils.AutoLinkingVar:       idp_utils.AutoLinkingDataSourceField,
+			idp_utils.IdpIDVar:                         idp_utils.IdPIDDataSourceField,
+			idp_utils.NameVar:                          idp_utils.NameDataSourceField,
+			BindingVar:                                 BindingDatasourceField,
+			MetadataXMLVar:                             MetadataXMLDatasourceField,
+			idp_utils.WithSignedRequestVar:             idp_utils.WithSignedRequestDataSourceField,
+			idp_utils.NameIDFormatVar:                  idp_utils.NameIDFormatDataSourceField,
+			idp_utils.TransientMappingAttributeNameVar: idp_utils.TransientMappingAttributeNameDataSourceField,
+			idp_utils.IsLinkingAllowedVar:              idp_utils.IsLinkingAllowedDataSourceField,
+			idp_utils.IsCreationAllowedVar:             idp_utils.IsCreationAllowedDataSourceField,
+			idp_utils.IsAutoCreationVar:                idp_utils.IsAutoCreationDataSourceField,
+			idp_utils.IsAutoUpdateVar:                  idp_utils.IsAutoUpdateDataSourceField,
+			idp_utils.AutoLinkingVar:                   idp_utils.AutoLinkingDataSourceField,
 		},
 		ReadContext: read,
 	}
@@ -8,23 +8,25 @@ import (
 )
 
 func GetResource() *schema.Resource {
-	return &schema.Resource{
+	return helper.WithDeletionProtection(&schema.Resource{
 		Description: "Resource representing a SAML IDP on the instance.",
 		Schema: map[string]*schema.Schema{
-			idp_utils.NameVar:              idp_utils.NameResourceField,
-			BindingVar:                     BindingResourceField,
-			WithSignedRequestVar:           WithSignedRequestResourceField,
-			MetadataXMLVar:                 MetadataXMLResourceField,
-			idp_utils.IsLinkingAllowedVar:  idp_utils.IsLinkingAllowedResourceField,
-			idp_utils.IsCreationAllowedVar: idp_utils.IsCreationAllowedResourceField,
-			idp_utils.IsAutoCreationVar:    idp_utils.IsAutoCreationResourceField,
-			idp_utils.IsAutoUpdateVar:      idp_utils.IsAutoUpdateResourceField,
-			idp_utils.AutoLinkingVar:       idp_utils.AutoLinkingResourceField,
+			idp_utils.NameVar:                          idp_utils.NameResourceField,
+			BindingVar:                                 BindingResourceField,
+			idp_utils.WithSignedRequestVar:             idp_utils.WithSignedRequestResourceField,
+			idp_utils.NameIDFormatVar:                  idp_utils.NameIDFormatResourceField,
+			idp_utils.TransientMappingAttributeNameVar: idp_utils.TransientMappingAttributeNameResourceField,
+			MetadataXMLVar:                             MetadataXMLResourceField,
+			idp_utils.IsLinkingAllowedVar:              idp_utils.IsLinkingAllowedResourceField,
+			idp_utils.IsCreationAllowedVar:             idp_utils.IsCreationAllowedResourceField,
+			idp_utils.IsAutoCreationVar:                idp_utils.IsAutoCreationResourceField,
+			idp_utils.IsAutoUpdateVar:                  idp_utils.IsAutoUpdateResourceField,
+			idp_utils.AutoLinkingVar:                   idp_utils.AutoLinkingResourceField,
 		},
 		ReadContext:   read,
 		UpdateContext: update,
 		CreateContext: create,
 		DeleteContext: idp_utils.Delete,
 		Importer:      helper.ImportWithID(idp_utils.IdpIDVar),
-	}
+	}, false)
 }
@@ -10,9 +10,8 @@ import (
 )
 
 const (
-	MetadataXMLVar       = "metadata_xml"
-	BindingVar           = "binding"
-	WithSignedRequestVar = "with_signed_request"
+	MetadataXMLVar = "metadata_xml"
+	BindingVar     = "binding"
 )
 
 var (
@@ -40,15 +39,4 @@ var (
 		Computed:    true,
 		Description: "The metadata XML as plain string",
 	}
-	WithSignedRequestResourceField = &schema.Schema{
-		Type:        schema.TypeBool,
-		Optional:    true,
-		Default:     false,
-		Description: "Whether the SAML IDP requires signed requests",
-	}
-	WithSignedRequestDatasourceField = &schema.Schema{
-		Type:        schema.TypeString,
-		Computed:    true,
-		Description: "Whether the SAML IDP requires signed requests",
-	}
 )
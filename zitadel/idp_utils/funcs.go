@@ -57,6 +57,20 @@ func ProviderOptionsValue(d *schema.ResourceData) *idp.Options {
 	}
 }
 
+func NameIDFormatValue(d *schema.ResourceData) *idp.SAMLNameIDFormat {
+	value, ok := d.GetOk(NameIDFormatVar)
+	if !ok {
+		return nil
+	}
+	format := idp.SAMLNameIDFormat(idp.SAMLNameIDFormat_value[value.(string)])
+	return &format
+}
+
+func TransientMappingAttributeNameValue(d *schema.ResourceData) *string {
+	value := StringValue(d, TransientMappingAttributeNameVar)
+	return &value
+}
+
 func InterfaceToStringSlice(in interface{}) []string {
 	slice := in.([]interface{})
 	ret := make([]string, 0)
@@ -12,6 +12,12 @@ import (
 )
 
 func RunInstanceIDPLifecyleTest(t *testing.T, resourceName, secretAttribute string) {
+	RunInstanceIDPLifecyleTestWithUpdatedSecret(t, resourceName, secretAttribute, "an_updated_secret")
+}
+
+// RunInstanceIDPLifecyleTestWithUpdatedSecret is like RunInstanceIDPLifecyleTest, but updates the secret to the given value,
+// which is needed for secrets that are validated
+func RunInstanceIDPLifecyleTestWithUpdatedSecret(t *testing.T, resourceName, secretAttribute, updatedSecret string) {
 	frame := test_utils.NewInstanceTestFrame(t, resourceName)
 	resourceExample, exampleAttributes := test_utils.ReadExample(t, test_utils.Resources, frame.ResourceType)
 	nameProperty := test_utils.AttributeValue(t, idp_utils.NameVar, exampleAttributes).AsString()
@@ -32,7 +38,7 @@ func RunInstanceIDPLifecyleTest(t *testing.T, resourceName, secretAttribute stri
 		nil,
 		test_utils.ReplaceAll(resourceExample, exampleProperty, exampleSecret),
 		true, false,
-		secretAttribute, exampleSecret, "an_updated_secret",
+		secretAttribute, exampleSecret, updatedSecret,
 		false,
 		CheckCreationAllowed(*frame),
 		helper.ZitadelGeneratedIdOnlyRegex,
@@ -20,6 +20,11 @@ const (
 	IsAutoCreationVar    = "is_auto_creation"
 	IsAutoUpdateVar      = "is_auto_update"
 	AutoLinkingVar       = "auto_linking"
+
+	IsIdTokenMappingVar              = "is_id_token_mapping"
+	WithSignedRequestVar             = "with_signed_request"
+	NameIDFormatVar                  = "name_id_format"
+	TransientMappingAttributeNameVar = "transient_mapping_attribute_name"
 )
 
 var (
@@ -130,4 +135,49 @@ var (
 		Computed:    true,
 		Description: "Enable if users should get prompted to link an existing ZITADEL user to an external account if the selected attribute matches" + helper.DescriptionEnumValuesList(idp.AutoLinkingOption_name),
 	}
+	IsIdTokenMappingResourceField = &schema.Schema{
+		Type:        schema.TypeBool,
+		Required:    true,
+		Description: "if true, provider information get mapped from the id token, not from the userinfo endpoint",
+	}
+	IsIdTokenMappingDataSourceField = &schema.Schema{
+		Type:        schema.TypeBool,
+		Computed:    true,
+		Description: "if true, provider information get mapped from the id token, not from the userinfo endpoint.",
+	}
+	WithSignedRequestResourceField = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Whether the SAML IDP requires signed requests",
+	}
+	WithSignedRequestDataSourceField = &schema.Schema{
+		Type:        schema.TypeBool,
+		Computed:    true,
+		Description: "Whether the SAML IDP requires signed requests",
+	}
+	NameIDFormatResourceField = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Computed:    true,
+		Description: "The name id format requested from the SAML IDP" + helper.DescriptionEnumValuesList(idp.SAMLNameIDFormat_name),
+		ValidateDiagFunc: func(value interface{}, path cty.Path) diag.Diagnostics {
+			return helper.EnumValueValidation(NameIDFormatVar, value, idp.SAMLNameIDFormat_value)
+		},
+	}
+	NameIDFormatDataSourceField = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The name id format requested from the SAML IDP",
+	}
+	TransientMappingAttributeNameResourceField = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Name of the attribute, which is used to map the user if the name id format is transient",
+	}
+	TransientMappingAttributeNameDataSourceField = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Name of the attribute, which is used to map the user if the name id format is transient",
+	}
 )
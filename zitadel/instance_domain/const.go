@@ -0,0 +1,7 @@
+package instance_domain
+
+const (
+	DomainVar      = "domain"
+	isPrimaryVar   = "is_primary"
+	isGeneratedVar = "is_generated"
+)
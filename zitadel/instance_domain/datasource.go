@@ -0,0 +1,29 @@
+package instance_domain
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func GetDatasource() *schema.Resource {
+	return &schema.Resource{
+		Description: "Datasource representing a custom domain of the instance.",
+		Schema: map[string]*schema.Schema{
+			DomainVar: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The custom domain",
+			},
+			isPrimaryVar: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Is the domain the primary domain of the instance",
+			},
+			isGeneratedVar: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Is the domain generated by ZITADEL on instance creation",
+			},
+		},
+		ReadContext: get,
+	}
+}
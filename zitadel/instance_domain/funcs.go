@@ -0,0 +1,148 @@
+package instance_domain
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/admin"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/instance"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/object"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/system"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
+)
+
+func delete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started delete")
+
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return diag.Errorf("failed to get client")
+	}
+
+	client, err := helper.GetSystemClient(ctx, clientinfo)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	instanceID, err := helper.GetInstanceID(ctx, clientinfo)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = client.RemoveDomain(ctx, &system.RemoveDomainRequest{
+		InstanceId: instanceID,
+		Domain:     d.Id(),
+	})
+	if err != nil {
+		return diag.Errorf("failed to delete instance domain: %v", err)
+	}
+	return nil
+}
+
+func create(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started create")
+
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return diag.Errorf("failed to get client")
+	}
+
+	client, err := helper.GetSystemClient(ctx, clientinfo)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	instanceID, err := helper.GetInstanceID(ctx, clientinfo)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	domain := d.Get(DomainVar).(string)
+	_, err = client.AddDomain(ctx, &system.AddDomainRequest{
+		InstanceId: instanceID,
+		Domain:     domain,
+	})
+	if err != nil {
+		return diag.Errorf("failed to create instance domain: %v", err)
+	}
+	d.SetId(domain)
+	return read(ctx, d, m)
+}
+
+func read(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started read")
+
+	domain, err := getDomain(ctx, d.Id(), m)
+	if err != nil {
+		return diag.Errorf("failed to get instance domain: %v", err)
+	}
+	if domain == nil {
+		d.SetId("")
+		return nil
+	}
+	return setDomain(d, domain)
+}
+
+func get(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started get")
+
+	domainName := d.Get(DomainVar).(string)
+	domain, err := getDomain(ctx, domainName, m)
+	if err != nil {
+		return diag.Errorf("failed to list instance domains: %v", err)
+	}
+	if domain == nil {
+		return diag.Errorf("instance domain %s not found", domainName)
+	}
+	d.SetId(domainName)
+	return setDomain(d, domain)
+}
+
+func setDomain(d *schema.ResourceData, domain *instance.Domain) diag.Diagnostics {
+	set := map[string]interface{}{
+		DomainVar:      domain.GetDomain(),
+		isPrimaryVar:   domain.GetPrimary(),
+		isGeneratedVar: domain.GetGenerated(),
+	}
+	for k, v := range set {
+		if err := d.Set(k, v); err != nil {
+			return diag.Errorf("failed to set %s of instance domain: %v", k, err)
+		}
+	}
+	return nil
+}
+
+// getDomain returns nil if the instance has no such domain
+func getDomain(ctx context.Context, domainName string, m interface{}) (*instance.Domain, error) {
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return nil, errors.New("failed to get client")
+	}
+
+	client, err := helper.GetAdminClient(ctx, clientinfo)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.ListInstanceDomains(ctx, &admin.ListInstanceDomainsRequest{
+		Queries: []*instance.DomainSearchQuery{{
+			Query: &instance.DomainSearchQuery_DomainQuery{
+				DomainQuery: &instance.DomainQuery{
+					Domain: domainName,
+					Method: object.TextQueryMethod_TEXT_QUERY_METHOD_EQUALS,
+				},
+			},
+		}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, domain := range resp.GetResult() {
+		if domain.GetDomain() == domainName {
+			return domain, nil
+		}
+	}
+	return nil, nil
+}
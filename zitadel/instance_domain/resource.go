@@ -0,0 +1,37 @@
+package instance_domain
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
+)
+
+func GetResource() *schema.Resource {
+	return helper.WithDeletionProtection(&schema.Resource{
+		Description: "Resource representing a custom domain of the instance. Custom domains are added through the system API, so the provider attributes system_api_user and system_api_key are required.",
+		Schema: map[string]*schema.Schema{
+			DomainVar: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The custom domain",
+				ForceNew:    true,
+			},
+			isPrimaryVar: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Is the domain the primary domain of the instance",
+			},
+			isGeneratedVar: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Is the domain generated by ZITADEL on instance creation",
+			},
+		},
+		CreateContext: create,
+		DeleteContext: delete,
+		ReadContext:   read,
+		Importer: helper.ImportWithAttributes(
+			helper.NewImportAttribute(DomainVar, helper.ConvertNonEmpty, false),
+		),
+	}, false)
+}
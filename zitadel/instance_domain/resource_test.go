@@ -0,0 +1,87 @@
+package instance_domain_test
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/admin"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/instance"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/object"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper/test_utils"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/instance_domain"
+)
+
+func TestAccInstanceDomain(t *testing.T) {
+	frame := test_utils.NewInstanceTestFrame(t, "zitadel_instance_domain")
+	resourceExample, exampleAttributes := test_utils.ReadExample(t, test_utils.Resources, frame.ResourceType)
+	exampleDomain := test_utils.AttributeValue(t, instance_domain.DomainVar, exampleAttributes).AsString()
+	exampleProperty := fmt.Sprintf("%s.%s", frame.UniqueResourcesID, exampleDomain)
+	updatedProperty := fmt.Sprintf("updated.%s", exampleProperty)
+	test_utils.RunLifecyleTest(
+		t,
+		frame.BaseTestFrame,
+		nil,
+		test_utils.ReplaceAll(resourceExample, exampleDomain, ""),
+		exampleProperty, updatedProperty,
+		"", "", "",
+		false,
+		checkRemoteProperty(frame),
+		regexp.MustCompile(fmt.Sprintf(`^%s$|^%s$`, regexp.QuoteMeta(exampleProperty), regexp.QuoteMeta(updatedProperty))),
+		test_utils.CheckIsNotFoundFromPropertyCheck(checkRemoteProperty(frame), updatedProperty),
+		test_utils.ImportResourceId(frame.BaseTestFrame),
+		helper.DeletionProtectionVar,
+	)
+}
+
+func checkRemoteProperty(frame *test_utils.InstanceTestFrame) func(string) resource.TestCheckFunc {
+	return func(expect string) resource.TestCheckFunc {
+		return func(_ *terraform.State) error {
+			resp, err := frame.ListInstanceDomains(frame, &admin.ListInstanceDomainsRequest{
+				Queries: []*instance.DomainSearchQuery{{
+					Query: &instance.DomainSearchQuery_DomainQuery{
+						DomainQuery: &instance.DomainQuery{
+							Domain: expect,
+							Method: object.TextQueryMethod_TEXT_QUERY_METHOD_EQUALS,
+						},
+					},
+				}},
+			})
+			if err != nil {
+				return err
+			}
+			if len(resp.GetResult()) == 0 {
+				return fmt.Errorf("expected to find %s, but didn't: %w", expect, test_utils.ErrNotFound)
+			}
+			return nil
+		}
+	}
+}
+
+func TestAccInstanceDomainDeletionProtection(t *testing.T) {
+	frame := test_utils.NewInstanceTestFrame(t, "zitadel_instance_domain")
+	domain := fmt.Sprintf("protected.%s.example.com", strings.ToLower(frame.UniqueResourcesID))
+	config := func(protected bool) string {
+		return fmt.Sprintf(`%s
+resource "zitadel_instance_domain" "default" {
+  domain              = "%s"
+  deletion_protection = %t
+}`, frame.ProviderSnippet, domain, protected)
+	}
+	resource.ParallelTest(t, resource.TestCase{
+		Steps: []resource.TestStep{{
+			Config: config(true),
+			Check:  checkRemoteProperty(frame)(domain),
+		}, { // The protection is removed in place, as a replacement would be refused
+			Config: config(false),
+			Check:  checkRemoteProperty(frame)(domain),
+		}},
+		CheckDestroy:             test_utils.CheckIsNotFoundFromPropertyCheck(checkRemoteProperty(frame), domain),
+		ProtoV6ProviderFactories: frame.ProtoV6ProviderFactories(),
+	})
+}
@@ -0,0 +1,36 @@
+package instance_features
+
+const (
+	LoginDefaultOrgVar     = "login_default_org"
+	improvedPerformanceVar = "improved_performance"
+	sourcesVar             = "sources"
+	loginV2Var             = "login_v2"
+	loginV2RequiredVar     = "required"
+	loginV2BaseURIVar      = "base_uri"
+
+	featuresEndpoint = "/v2beta/features/instance"
+)
+
+type feature struct {
+	key         string
+	jsonKey     string
+	description string
+}
+
+// features are the boolean instance features
+var features = []feature{
+	{key: LoginDefaultOrgVar, jsonKey: "loginDefaultOrg", description: "The login UI will use the settings of the default org (and not from the instance) if no organization context is set"},
+	{key: "oidc_trigger_introspection_projections", jsonKey: "oidcTriggerIntrospectionProjections", description: "Enable projection triggers during an introspection request"},
+	{key: "oidc_legacy_introspection", jsonKey: "oidcLegacyIntrospection", description: "Use the legacy implementation of the introspection endpoint"},
+	{key: "user_schema", jsonKey: "userSchema", description: "Enable the user schema API (experimental)"},
+	{key: "oidc_token_exchange", jsonKey: "oidcTokenExchange", description: "Enable the OIDC token exchange grant type (experimental)"},
+	{key: "actions", jsonKey: "actions", description: "Enable the actions v2 API (experimental)"},
+	{key: "web_key", jsonKey: "webKey", description: "Enable the web key API and the usage of web keys for token signing (experimental)"},
+	{key: "debug_oidc_parent_error", jsonKey: "debugOidcParentError", description: "Return the parent errors to OIDC clients for debugging purposes"},
+	{key: "oidc_single_v1_session_termination", jsonKey: "oidcSingleV1SessionTermination", description: "Terminate the single v1 session of a user on OIDC end session requests"},
+}
+
+const (
+	improvedPerformanceJSONKey = "improvedPerformance"
+	loginV2JSONKey             = "loginV2"
+)
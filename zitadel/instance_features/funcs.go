@@ -0,0 +1,250 @@
+package instance_features
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
+)
+
+const sourceInstance = "instance"
+
+type instanceFeatures struct {
+	resourceOwner       string
+	enabled             map[string]bool
+	improvedPerformance []string
+	loginV2             *loginV2
+	sources             map[string]string
+}
+
+type loginV2 struct {
+	Required bool   `json:"required"`
+	BaseURI  string `json:"baseUri,omitempty"`
+}
+
+func delete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started delete")
+
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return diag.Errorf("failed to get client")
+	}
+
+	if err := helper.RESTCall(ctx, clientinfo, http.MethodDelete, featuresEndpoint, nil, nil); err != nil {
+		return diag.Errorf("failed to reset instance features: %v", err)
+	}
+	return nil
+}
+
+func update(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started update")
+
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return diag.Errorf("failed to get client")
+	}
+
+	configured := configuredFeatures(d.GetRawConfig())
+	sources := d.Get(sourcesVar).(map[string]interface{})
+	if d.IsNewResource() {
+		current, err := getFeatures(ctx, clientinfo)
+		if err != nil {
+			return diag.Errorf("failed to get instance features: %v", err)
+		}
+		sources = make(map[string]interface{}, len(current.sources))
+		for k, v := range current.sources {
+			sources[k] = v
+		}
+	}
+	// features can only be reset all together, so we reset them if a feature is set on the instance but not configured anymore
+	for key, source := range sources {
+		if source == sourceInstance && !configured[key] {
+			if err := helper.RESTCall(ctx, clientinfo, http.MethodDelete, featuresEndpoint, nil, nil); err != nil {
+				return diag.Errorf("failed to reset instance features: %v", err)
+			}
+			break
+		}
+	}
+	body := setRequest(d, configured)
+	if len(body) > 0 {
+		if err := helper.RESTCall(ctx, clientinfo, http.MethodPut, featuresEndpoint, body, nil); err != nil {
+			return diag.Errorf("failed to set instance features: %v", err)
+		}
+	}
+	return read(ctx, d, m)
+}
+
+// customizeDiff marks features as computed which are set on the instance but not configured anymore,
+// so the update resets them and they inherit the system defaults again
+func customizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+	configured := configuredFeatures(d.GetRawConfig())
+	reset := false
+	for key, source := range d.Get(sourcesVar).(map[string]interface{}) {
+		if source != sourceInstance || configured[key] {
+			continue
+		}
+		if err := d.SetNewComputed(key); err != nil {
+			return err
+		}
+		reset = true
+	}
+	if reset {
+		return d.SetNewComputed(sourcesVar)
+	}
+	return nil
+}
+
+// configuredFeatures returns for each feature whether it is set in the configuration
+func configuredFeatures(rawConfig cty.Value) map[string]bool {
+	configured := make(map[string]bool)
+	for _, key := range append(featureKeys(), improvedPerformanceVar) {
+		configured[key] = !rawConfig.GetAttr(key).IsNull()
+	}
+	// an omitted block is an empty list instead of null
+	loginV2Config := rawConfig.GetAttr(loginV2Var)
+	configured[loginV2Var] = !loginV2Config.IsNull() && (!loginV2Config.IsKnown() || loginV2Config.LengthInt() > 0)
+	return configured
+}
+
+func read(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started read")
+
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return diag.Errorf("failed to get client")
+	}
+
+	current, err := getFeatures(ctx, clientinfo)
+	if err != nil {
+		return diag.Errorf("failed to get instance features: %v", err)
+	}
+	set := map[string]interface{}{
+		improvedPerformanceVar: current.improvedPerformance,
+		loginV2Var: []map[string]interface{}{{
+			loginV2RequiredVar: current.loginV2.Required,
+			loginV2BaseURIVar:  current.loginV2.BaseURI,
+		}},
+		sourcesVar: current.sources,
+	}
+	for key, enabled := range current.enabled {
+		set[key] = enabled
+	}
+	for k, v := range set {
+		if err := d.Set(k, v); err != nil {
+			return diag.Errorf("failed to set %s of instance features: %v", k, err)
+		}
+	}
+	d.SetId(current.resourceOwner)
+	return nil
+}
+
+func getFeatures(ctx context.Context, clientinfo *helper.ClientInfo) (*instanceFeatures, error) {
+	resp := make(map[string]json.RawMessage)
+	if err := helper.RESTCall(ctx, clientinfo, http.MethodGet, featuresEndpoint+"?inheritance=true", nil, &resp); err != nil {
+		return nil, err
+	}
+	return parseFeatures(resp)
+}
+
+// parseFeatures reads the effective features and their sources from the response of the feature service
+func parseFeatures(resp map[string]json.RawMessage) (*instanceFeatures, error) {
+	parsed := &instanceFeatures{
+		enabled:             make(map[string]bool, len(features)),
+		improvedPerformance: make([]string, 0),
+		loginV2:             &loginV2{},
+		sources:             make(map[string]string, len(features)+2),
+	}
+	if details, ok := resp["details"]; ok {
+		var objectDetails struct {
+			ResourceOwner string `json:"resourceOwner"`
+		}
+		if err := json.Unmarshal(details, &objectDetails); err != nil {
+			return nil, fmt.Errorf("failed to parse details: %w", err)
+		}
+		parsed.resourceOwner = objectDetails.ResourceOwner
+	}
+	for _, f := range features {
+		var flag struct {
+			Enabled bool   `json:"enabled"`
+			Source  string `json:"source"`
+		}
+		if raw, ok := resp[f.jsonKey]; ok {
+			if err := json.Unmarshal(raw, &flag); err != nil {
+				return nil, fmt.Errorf("failed to parse feature %s: %w", f.jsonKey, err)
+			}
+		}
+		parsed.enabled[f.key] = flag.Enabled
+		parsed.sources[f.key] = sourceName(flag.Source)
+	}
+	var improvedPerformance struct {
+		ExecutionPaths []string `json:"executionPaths"`
+		Source         string   `json:"source"`
+	}
+	if raw, ok := resp[improvedPerformanceJSONKey]; ok {
+		if err := json.Unmarshal(raw, &improvedPerformance); err != nil {
+			return nil, fmt.Errorf("failed to parse feature %s: %w", improvedPerformanceJSONKey, err)
+		}
+	}
+	parsed.improvedPerformance = append(parsed.improvedPerformance, improvedPerformance.ExecutionPaths...)
+	parsed.sources[improvedPerformanceVar] = sourceName(improvedPerformance.Source)
+	var login struct {
+		loginV2
+		Source string `json:"source"`
+	}
+	if raw, ok := resp[loginV2JSONKey]; ok {
+		if err := json.Unmarshal(raw, &login); err != nil {
+			return nil, fmt.Errorf("failed to parse feature %s: %w", loginV2JSONKey, err)
+		}
+	}
+	parsed.loginV2 = &login.loginV2
+	parsed.sources[loginV2Var] = sourceName(login.Source)
+	return parsed, nil
+}
+
+// setRequest returns the request body containing only the configured features
+func setRequest(d *schema.ResourceData, configured map[string]bool) map[string]interface{} {
+	body := make(map[string]interface{})
+	for _, f := range features {
+		if configured[f.key] {
+			body[f.jsonKey] = d.Get(f.key).(bool)
+		}
+	}
+	if configured[improvedPerformanceVar] {
+		body[improvedPerformanceJSONKey] = helper.SetToStringSlice(d.Get(improvedPerformanceVar).(*schema.Set))
+	}
+	if configured[loginV2Var] {
+		body[loginV2JSONKey] = loginV2{
+			Required: d.Get(loginV2Var + ".0." + loginV2RequiredVar).(bool),
+			BaseURI:  d.Get(loginV2Var + ".0." + loginV2BaseURIVar).(string),
+		}
+	}
+	return body
+}
+
+// sourceName maps the source enum of the feature service to default, system or instance
+func sourceName(source string) string {
+	name := strings.ToLower(strings.TrimPrefix(source, "SOURCE_"))
+	if name == "" || name == "unspecified" {
+		return "default"
+	}
+	return name
+}
+
+func featureKeys() []string {
+	keys := make([]string, len(features))
+	for i, f := range features {
+		keys[i] = f.key
+	}
+	return keys
+}
@@ -0,0 +1,56 @@
+package instance_features
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestParseFeatures(t *testing.T) {
+	resp := make(map[string]json.RawMessage)
+	if err := json.Unmarshal([]byte(`{
+		"details": {"sequence": "12", "resourceOwner": "123456789012345678"},
+		"loginDefaultOrg": {"enabled": true, "source": "SOURCE_SYSTEM"},
+		"userSchema": {"enabled": true, "source": "SOURCE_INSTANCE"},
+		"oidcTokenExchange": {"source": "SOURCE_UNSPECIFIED"},
+		"improvedPerformance": {"executionPaths": ["IMPROVED_PERFORMANCE_ORG_BY_ID"], "source": "SOURCE_INSTANCE"},
+		"loginV2": {"required": true, "baseUri": "https://login.example.com/ui/v2/login", "source": "SOURCE_INSTANCE"}
+	}`), &resp); err != nil {
+		t.Fatal(err)
+	}
+	parsed, err := parseFeatures(resp)
+	if err != nil {
+		t.Fatalf("parseFeatures() unexpected error: %v", err)
+	}
+	if parsed.resourceOwner != "123456789012345678" {
+		t.Errorf("parseFeatures() resourceOwner = %s", parsed.resourceOwner)
+	}
+	for key, expect := range map[string]bool{
+		LoginDefaultOrgVar:    true,
+		"user_schema":         true,
+		"oidc_token_exchange": false,
+		"actions":             false,
+	} {
+		if parsed.enabled[key] != expect {
+			t.Errorf("parseFeatures() %s = %t, want %t", key, parsed.enabled[key], expect)
+		}
+	}
+	for key, expect := range map[string]string{
+		LoginDefaultOrgVar:     "system",
+		"user_schema":          "instance",
+		"oidc_token_exchange":  "default",
+		"actions":              "default",
+		improvedPerformanceVar: "instance",
+		loginV2Var:             "instance",
+	} {
+		if parsed.sources[key] != expect {
+			t.Errorf("parseFeatures() source of %s = %s, want %s", key, parsed.sources[key], expect)
+		}
+	}
+	if !reflect.DeepEqual(parsed.improvedPerformance, []string{"IMPROVED_PERFORMANCE_ORG_BY_ID"}) {
+		t.Errorf("parseFeatures() improvedPerformance = %v", parsed.improvedPerformance)
+	}
+	if expect := (loginV2{Required: true, BaseURI: "https://login.example.com/ui/v2/login"}); *parsed.loginV2 != expect {
+		t.Errorf("parseFeatures() loginV2 = %+v, want %+v", *parsed.loginV2, expect)
+	}
+}
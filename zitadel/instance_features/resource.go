@@ -0,0 +1,65 @@
+package instance_features
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
+)
+
+func GetResource() *schema.Resource {
+	featureSchema := map[string]*schema.Schema{
+		improvedPerformanceVar: {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "Execution paths for which the improved performance implementation is used, for example IMPROVED_PERFORMANCE_ORG_BY_ID",
+		},
+		loginV2Var: {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Computed:    true,
+			MaxItems:    1,
+			Description: "Use the login UI v2 for the authentication of users. If not set, the inherited value is used",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					loginV2RequiredVar: {
+						Type:        schema.TypeBool,
+						Required:    true,
+						Description: "Require the login UI v2, users are redirected to it instead of the login UI v1",
+					},
+					loginV2BaseURIVar: {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Computed:    true,
+						Description: "Base URI of the login UI v2, ZITADEL uses its default if not set",
+					},
+				},
+			},
+		},
+		sourcesVar: {
+			Type:        schema.TypeMap,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "Source of the effective value of each feature, which is one of default, system or instance",
+		},
+	}
+	for _, f := range features {
+		featureSchema[f.key] = &schema.Schema{
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+			Description: f.description + ". If not set, the inherited value is used",
+		}
+	}
+	return &schema.Resource{
+		Description:   "Resource representing the feature flags of the instance. Features which are not configured inherit the system defaults. All features set on the instance are reset on destroy.",
+		Schema:        featureSchema,
+		CreateContext: update,
+		UpdateContext: update,
+		DeleteContext: delete,
+		ReadContext:   read,
+		CustomizeDiff: customizeDiff,
+		Importer:      helper.ImportWithEmptyID(),
+	}
+}
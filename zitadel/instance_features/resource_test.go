@@ -0,0 +1,75 @@
+package instance_features_test
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper/test_utils"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/instance_features"
+)
+
+func TestAccInstanceFeatures(t *testing.T) {
+	frame := test_utils.NewInstanceTestFrame(t, "zitadel_instance_features")
+	resourceExample, exampleAttributes := test_utils.ReadExample(t, test_utils.Resources, frame.ResourceType)
+	exampleProperty := test_utils.AttributeValue(t, instance_features.LoginDefaultOrgVar, exampleAttributes).True()
+	test_utils.RunLifecyleTest(
+		t,
+		frame.BaseTestFrame,
+		nil,
+		test_utils.ReplaceAll(resourceExample, exampleProperty, ""),
+		exampleProperty, !exampleProperty,
+		"", "", "",
+		false,
+		checkRemoteProperty(*frame),
+		helper.ZitadelGeneratedIdOnlyRegex,
+		test_utils.CheckNothing,
+		test_utils.ImportNothing,
+	)
+}
+
+func TestAccInstanceFeaturesReset(t *testing.T) {
+	frame := test_utils.NewInstanceTestFrame(t, "zitadel_instance_features")
+	resource.Test(t, resource.TestCase{
+		Steps: []resource.TestStep{{
+			Config: fmt.Sprintf(`%s
+resource "zitadel_instance_features" "default" {
+  login_default_org = true
+}`, frame.ProviderSnippet),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr(frame.TerraformName, "sources."+instance_features.LoginDefaultOrgVar, "instance"),
+				checkRemoteProperty(*frame)(true),
+			),
+		}, { // Removing the feature from the configuration resets it to the inherited value
+			Config: fmt.Sprintf(`%s
+resource "zitadel_instance_features" "default" {}`, frame.ProviderSnippet),
+			Check: resource.TestMatchResourceAttr(frame.TerraformName, "sources."+instance_features.LoginDefaultOrgVar, regexp.MustCompile(`^(default|system)$`)),
+		}},
+		ProtoV6ProviderFactories: frame.ProtoV6ProviderFactories(),
+	})
+}
+
+func checkRemoteProperty(frame test_utils.InstanceTestFrame) func(bool) resource.TestCheckFunc {
+	return func(expect bool) resource.TestCheckFunc {
+		return func(state *terraform.State) error {
+			resp := struct {
+				LoginDefaultOrg struct {
+					Enabled bool `json:"enabled"`
+				} `json:"loginDefaultOrg"`
+			}{}
+			if err := helper.RESTCall(frame, frame.ClientInfo, http.MethodGet, "/v2beta/features/instance?inheritance=true", nil, &resp); err != nil {
+				return fmt.Errorf("getting instance features failed: %w", err)
+			}
+			actual := resp.LoginDefaultOrg.Enabled
+			if actual != expect {
+				return fmt.Errorf("expected %t, but got %t", expect, actual)
+			}
+			return nil
+		}
+	}
+}
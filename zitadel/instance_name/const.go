@@ -0,0 +1,5 @@
+package instance_name
+
+const (
+	NameVar = "name"
+)
@@ -0,0 +1,71 @@
+package instance_name
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/admin"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/system"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
+)
+
+func delete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started delete")
+	// an instance always has a name, so it is only removed from the state
+	return nil
+}
+
+func update(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started update")
+
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return diag.Errorf("failed to get client")
+	}
+
+	client, err := helper.GetSystemClient(ctx, clientinfo)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	instanceID, err := helper.GetInstanceID(ctx, clientinfo)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = client.UpdateInstance(ctx, &system.UpdateInstanceRequest{
+		InstanceId:   instanceID,
+		InstanceName: d.Get(NameVar).(string),
+	})
+	if err != nil {
+		return diag.Errorf("failed to update instance name: %v", err)
+	}
+	return read(ctx, d, m)
+}
+
+func read(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started read")
+
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return diag.Errorf("failed to get client")
+	}
+
+	client, err := helper.GetAdminClient(ctx, clientinfo)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resp, err := client.GetMyInstance(ctx, &admin.GetMyInstanceRequest{})
+	if err != nil {
+		return diag.Errorf("failed to get instance: %v", err)
+	}
+	instance := resp.GetInstance()
+	if err := d.Set(NameVar, instance.GetName()); err != nil {
+		return diag.Errorf("failed to set %s of instance: %v", NameVar, err)
+	}
+	d.SetId(instance.GetId())
+	return nil
+}
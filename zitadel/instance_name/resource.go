@@ -0,0 +1,25 @@
+package instance_name
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
+)
+
+func GetResource() *schema.Resource {
+	return &schema.Resource{
+		Description: "Resource representing the name of the instance. The name is changed through the system API, so the provider attributes system_api_user and system_api_key are required. The name is left unchanged on destroy.",
+		Schema: map[string]*schema.Schema{
+			NameVar: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the instance",
+			},
+		},
+		CreateContext: update,
+		UpdateContext: update,
+		DeleteContext: delete,
+		ReadContext:   read,
+		Importer:      helper.ImportWithEmptyID(),
+	}
+}
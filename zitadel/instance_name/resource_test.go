@@ -0,0 +1,50 @@
+package instance_name_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/admin"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper/test_utils"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/instance_name"
+)
+
+func TestAccInstanceName(t *testing.T) {
+	frame := test_utils.NewInstanceTestFrame(t, "zitadel_instance_name")
+	resourceExample, exampleAttributes := test_utils.ReadExample(t, test_utils.Resources, frame.ResourceType)
+	exampleProperty := test_utils.AttributeValue(t, instance_name.NameVar, exampleAttributes).AsString()
+	initialProperty := "initialinstancename_" + frame.UniqueResourcesID
+	updatedProperty := "updatedinstancename_" + frame.UniqueResourcesID
+	test_utils.RunLifecyleTest(
+		t,
+		frame.BaseTestFrame,
+		nil,
+		test_utils.ReplaceAll(resourceExample, exampleProperty, ""),
+		initialProperty, updatedProperty,
+		"", "", "",
+		false,
+		checkRemoteProperty(frame),
+		helper.ZitadelGeneratedIdOnlyRegex,
+		test_utils.CheckNothing,
+		test_utils.ImportNothing,
+	)
+}
+
+func checkRemoteProperty(frame *test_utils.InstanceTestFrame) func(string) resource.TestCheckFunc {
+	return func(expect string) resource.TestCheckFunc {
+		return func(_ *terraform.State) error {
+			resp, err := frame.GetMyInstance(frame, &admin.GetMyInstanceRequest{})
+			if err != nil {
+				return err
+			}
+			if actual := resp.GetInstance().GetName(); actual != expect {
+				return fmt.Errorf("expected %s, but got %s", expect, actual)
+			}
+			return nil
+		}
+	}
+}
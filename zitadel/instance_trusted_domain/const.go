@@ -0,0 +1,5 @@
+package instance_trusted_domain
+
+const (
+	DomainVar = "domain"
+)
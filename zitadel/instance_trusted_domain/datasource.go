@@ -0,0 +1,19 @@
+package instance_trusted_domain
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func GetDatasource() *schema.Resource {
+	return &schema.Resource{
+		Description: "Datasource representing a trusted domain of the instance.",
+		Schema: map[string]*schema.Schema{
+			DomainVar: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The trusted domain",
+			},
+		},
+		ReadContext: get,
+	}
+}
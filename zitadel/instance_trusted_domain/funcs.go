@@ -0,0 +1,125 @@
+package instance_trusted_domain
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/admin"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/instance"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/object"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
+)
+
+func delete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started delete")
+
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return diag.Errorf("failed to get client")
+	}
+
+	client, err := helper.GetAdminClient(ctx, clientinfo)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = client.RemoveInstanceTrustedDomain(ctx, &admin.RemoveInstanceTrustedDomainRequest{
+		Domain: d.Id(),
+	})
+	if err != nil {
+		return diag.Errorf("failed to delete trusted domain: %v", err)
+	}
+	return nil
+}
+
+func create(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started create")
+
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return diag.Errorf("failed to get client")
+	}
+
+	client, err := helper.GetAdminClient(ctx, clientinfo)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	domain := d.Get(DomainVar).(string)
+	_, err = client.AddInstanceTrustedDomain(ctx, &admin.AddInstanceTrustedDomainRequest{
+		Domain: domain,
+	})
+	if err != nil {
+		return diag.Errorf("failed to create trusted domain: %v", err)
+	}
+	d.SetId(domain)
+	return nil
+}
+
+func read(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started read")
+
+	found, err := exists(ctx, d.Id(), m)
+	if err != nil {
+		return diag.Errorf("failed to get trusted domain: %v", err)
+	}
+	if !found {
+		d.SetId("")
+		return nil
+	}
+	if err := d.Set(DomainVar, d.Id()); err != nil {
+		return diag.Errorf("failed to set %s of trusted domain: %v", DomainVar, err)
+	}
+	return nil
+}
+
+func get(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started get")
+
+	domain := d.Get(DomainVar).(string)
+	found, err := exists(ctx, domain, m)
+	if err != nil {
+		return diag.Errorf("failed to get trusted domain: %v", err)
+	}
+	if !found {
+		return diag.Errorf("trusted domain %s not found", domain)
+	}
+	d.SetId(domain)
+	return nil
+}
+
+func exists(ctx context.Context, domain string, m interface{}) (bool, error) {
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return false, errors.New("failed to get client")
+	}
+
+	client, err := helper.GetAdminClient(ctx, clientinfo)
+	if err != nil {
+		return false, err
+	}
+
+	resp, err := client.ListInstanceTrustedDomains(ctx, &admin.ListInstanceTrustedDomainsRequest{
+		Queries: []*instance.TrustedDomainSearchQuery{{
+			Query: &instance.TrustedDomainSearchQuery_DomainQuery{
+				DomainQuery: &instance.DomainQuery{
+					Domain: domain,
+					Method: object.TextQueryMethod_TEXT_QUERY_METHOD_EQUALS,
+				},
+			},
+		}},
+	})
+	if err != nil {
+		return false, err
+	}
+	for _, trustedDomain := range resp.GetResult() {
+		if trustedDomain.GetDomain() == domain {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,27 @@
+package instance_trusted_domain
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
+)
+
+func GetResource() *schema.Resource {
+	return &schema.Resource{
+		Description: "Resource representing a trusted domain of the instance, which can be used as public host in the requests to ZITADEL, for example behind a reverse proxy.",
+		Schema: map[string]*schema.Schema{
+			DomainVar: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The trusted domain",
+				ForceNew:    true,
+			},
+		},
+		CreateContext: create,
+		DeleteContext: delete,
+		ReadContext:   read,
+		Importer: helper.ImportWithAttributes(
+			helper.NewImportAttribute(DomainVar, helper.ConvertNonEmpty, false),
+		),
+	}
+}
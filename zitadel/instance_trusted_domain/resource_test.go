@@ -0,0 +1,61 @@
+package instance_trusted_domain_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/admin"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/instance"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/object"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper/test_utils"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/instance_trusted_domain"
+)
+
+func TestAccInstanceTrustedDomain(t *testing.T) {
+	frame := test_utils.NewInstanceTestFrame(t, "zitadel_instance_trusted_domain")
+	resourceExample, exampleAttributes := test_utils.ReadExample(t, test_utils.Resources, frame.ResourceType)
+	exampleDomain := test_utils.AttributeValue(t, instance_trusted_domain.DomainVar, exampleAttributes).AsString()
+	exampleProperty := fmt.Sprintf("%s.%s", frame.UniqueResourcesID, exampleDomain)
+	updatedProperty := fmt.Sprintf("updated.%s", exampleProperty)
+	test_utils.RunLifecyleTest(
+		t,
+		frame.BaseTestFrame,
+		nil,
+		test_utils.ReplaceAll(resourceExample, exampleDomain, ""),
+		exampleProperty, updatedProperty,
+		"", "", "",
+		false,
+		checkRemoteProperty(frame),
+		regexp.MustCompile(fmt.Sprintf(`^%s$|^%s$`, regexp.QuoteMeta(exampleProperty), regexp.QuoteMeta(updatedProperty))),
+		test_utils.CheckIsNotFoundFromPropertyCheck(checkRemoteProperty(frame), updatedProperty),
+		test_utils.ImportResourceId(frame.BaseTestFrame),
+	)
+}
+
+func checkRemoteProperty(frame *test_utils.InstanceTestFrame) func(string) resource.TestCheckFunc {
+	return func(expect string) resource.TestCheckFunc {
+		return func(_ *terraform.State) error {
+			resp, err := frame.ListInstanceTrustedDomains(frame, &admin.ListInstanceTrustedDomainsRequest{
+				Queries: []*instance.TrustedDomainSearchQuery{{
+					Query: &instance.TrustedDomainSearchQuery_DomainQuery{
+						DomainQuery: &instance.DomainQuery{
+							Domain: expect,
+							Method: object.TextQueryMethod_TEXT_QUERY_METHOD_EQUALS,
+						},
+					},
+				}},
+			})
+			if err != nil {
+				return err
+			}
+			if len(resp.GetResult()) == 0 {
+				return fmt.Errorf("expected to find %s, but didn't: %w", expect, test_utils.ErrNotFound)
+			}
+			return nil
+		}
+	}
+}
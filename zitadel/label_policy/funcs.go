@@ -10,11 +10,17 @@ import (
 	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/policy"
 
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/label_policy_utils"
 )
 
 func delete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	tflog.Info(ctx, "started delete")
 
+	if !d.Get(ResetToDefaultVar).(bool) {
+		tflog.Info(ctx, "custom label policy is kept as reset_to_default is disabled")
+		return nil
+	}
+
 	clientinfo, ok := m.(*helper.ClientInfo)
 	if !ok {
 		return diag.Errorf("failed to get client")
@@ -32,6 +38,27 @@ func delete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Dia
 	return nil
 }
 
+func removeAsset(ctx context.Context, clientinfo *helper.ClientInfo, d *schema.ResourceData, asset label_policy_utils.Asset) error {
+	client, err := helper.GetManagementClient(ctx, clientinfo)
+	if err != nil {
+		return err
+	}
+	ctx = helper.CtxWithID(ctx, d)
+	switch asset.Endpoint {
+	case logoURL:
+		_, err = client.RemoveCustomLabelPolicyLogo(ctx, &management.RemoveCustomLabelPolicyLogoRequest{})
+	case logoDarkURL:
+		_, err = client.RemoveCustomLabelPolicyLogoDark(ctx, &management.RemoveCustomLabelPolicyLogoDarkRequest{})
+	case iconURL:
+		_, err = client.RemoveCustomLabelPolicyIcon(ctx, &management.RemoveCustomLabelPolicyIconRequest{})
+	case iconDarkURL:
+		_, err = client.RemoveCustomLabelPolicyIconDark(ctx, &management.RemoveCustomLabelPolicyIconDarkRequest{})
+	case fontURL:
+		_, err = client.RemoveCustomLabelPolicyFont(ctx, &management.RemoveCustomLabelPolicyFontRequest{})
+	}
+	return err
+}
+
 func update(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	tflog.Info(ctx, "started update")
 
@@ -78,29 +105,18 @@ func update(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Dia
 		d.SetId(resp.Details.ResourceOwner)
 	}
 
-	if d.HasChanges(LogoHashVar, LogoPathVar) {
-		if err := helper.OrgFormFilePost(ctx, clientinfo, logoURL, d.Get(LogoPathVar).(string), org); err != nil {
-			return diag.Errorf("failed to upload logo: %v", err)
-		}
-	}
-	if d.HasChanges(LogoDarkHashVar, LogoDarkPathVar) {
-		if err := helper.OrgFormFilePost(ctx, clientinfo, logoDarkURL, d.Get(LogoDarkPathVar).(string), org); err != nil {
-			return diag.Errorf("failed to upload logo dark: %v", err)
+	for _, asset := range assets {
+		if !d.HasChanges(asset.HashVar, asset.PathVar, asset.ContentVar) {
+			continue
 		}
-	}
-	if d.HasChanges(IconHashVar, IconPathVar) {
-		if err := helper.OrgFormFilePost(ctx, clientinfo, iconURL, d.Get(IconPathVar).(string), org); err != nil {
-			return diag.Errorf("failed to upload icon: %v", err)
-		}
-	}
-	if d.HasChanges(IconDarkHashVar, IconDarkPathVar) {
-		if err := helper.OrgFormFilePost(ctx, clientinfo, iconDarkURL, d.Get(IconDarkPathVar).(string), org); err != nil {
-			return diag.Errorf("failed to upload icon dark: %v", err)
+		if !asset.IsConfigured(d) {
+			if err := removeAsset(ctx, clientinfo, d, asset); err != nil {
+				return diag.Errorf("failed to remove %s: %v", asset.Name, err)
+			}
+			continue
 		}
-	}
-	if d.HasChanges(FontHashVar, FontPathVar) {
-		if err := helper.OrgFormFilePost(ctx, clientinfo, fontURL, d.Get(FontPathVar).(string), org); err != nil {
-			return diag.Errorf("failed to upload font: %v", err)
+		if err := asset.Upload(ctx, clientinfo, d, org); err != nil {
+			return err
 		}
 	}
 
@@ -120,6 +136,11 @@ func update(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Dia
 		IconHashVar,
 		IconDarkHashVar,
 		FontHashVar,
+		LogoContentVar,
+		LogoDarkContentVar,
+		IconContentVar,
+		IconDarkContentVar,
+		FontContentVar,
 		themeModeVar,
 	) {
 		if d.Get(SetActiveVar).(bool) {
@@ -163,29 +184,9 @@ func create(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Dia
 	}
 	d.SetId(org)
 
-	if d.Get(LogoHashVar) != "" && d.Get(LogoPathVar) != "" {
-		if err := helper.OrgFormFilePost(ctx, clientinfo, logoURL, d.Get(LogoPathVar).(string), org); err != nil {
-			return diag.Errorf("failed to upload logo: %v", err)
-		}
-	}
-	if d.Get(LogoDarkHashVar) != "" && d.Get(LogoDarkPathVar) != "" {
-		if err := helper.OrgFormFilePost(ctx, clientinfo, logoDarkURL, d.Get(LogoDarkPathVar).(string), org); err != nil {
-			return diag.Errorf("failed to upload logo dark: %v", err)
-		}
-	}
-	if d.Get(IconHashVar) != "" && d.Get(IconPathVar) != "" {
-		if err := helper.OrgFormFilePost(ctx, clientinfo, iconURL, d.Get(IconPathVar).(string), org); err != nil {
-			return diag.Errorf("failed to upload icon: %v", err)
-		}
-	}
-	if d.Get(IconDarkHashVar) != "" && d.Get(IconDarkPathVar) != "" {
-		if err := helper.OrgFormFilePost(ctx, clientinfo, iconDarkURL, d.Get(IconDarkPathVar).(string), org); err != nil {
-			return diag.Errorf("failed to upload icon dark: %v", err)
-		}
-	}
-	if d.Get(FontHashVar) != "" && d.Get(FontPathVar) != "" {
-		if err := helper.OrgFormFilePost(ctx, clientinfo, fontURL, d.Get(FontPathVar).(string), org); err != nil {
-			return diag.Errorf("failed to upload font: %v", err)
+	for _, asset := range assets {
+		if err := asset.Upload(ctx, clientinfo, d, org); err != nil {
+			return err
 		}
 	}
 
@@ -245,6 +246,16 @@ func read(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagn
 		themeModeVar:           policy.GetThemeMode().String(),
 	}
 
+	for _, asset := range assets {
+		hash, err := asset.RemoteHash(ctx, clientinfo, set[asset.URLVar].(string))
+		if err != nil {
+			// an unavailable asset shouldn't fail the whole read, so the previous hash is kept
+			tflog.Warn(ctx, "failed to download asset, keeping the previous hash", map[string]interface{}{"url": set[asset.URLVar], "error": err.Error()})
+			hash = d.Get(asset.HashVar).(string)
+		}
+		set[asset.HashVar] = hash
+	}
+
 	for k, v := range set {
 		if err := d.Set(k, v); err != nil {
 			return diag.Errorf("failed to set %s of label policy: %v", k, err)
@@ -7,6 +7,7 @@ import (
 	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/policy"
 
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/label_policy_utils"
 )
 
 func GetResource() *schema.Resource {
@@ -15,9 +16,10 @@ func GetResource() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			helper.OrgIDVar: helper.OrgIDResourceField,
 			primaryColorVar: {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "hex value for primary color",
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "hex value for primary color",
+				ValidateDiagFunc: label_policy_utils.ColorValidation,
 			},
 			hideLoginNameSuffixVar: {
 				Type:        schema.TypeBool,
@@ -25,39 +27,46 @@ func GetResource() *schema.Resource {
 				Description: "hides the org suffix on the login form if the scope \"urn:zitadel:iam:org:domain:primary:{domainname}\" is set. Details about this scope in https://zitadel.com/docs/apis/openidoauth/scopes#reserved-scopes",
 			},
 			warnColorVar: {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "hex value for warn color",
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "hex value for warn color",
+				ValidateDiagFunc: label_policy_utils.ColorValidation,
 			},
 			backgroundColorVar: {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "hex value for background color",
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "hex value for background color",
+				ValidateDiagFunc: label_policy_utils.ColorValidation,
 			},
 			fontColorVar: {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "hex value for font color",
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "hex value for font color",
+				ValidateDiagFunc: label_policy_utils.ColorValidation,
 			},
 			primaryColorDarkVar: {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "hex value for primary color dark theme",
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "hex value for primary color dark theme",
+				ValidateDiagFunc: label_policy_utils.ColorValidation,
 			},
 			backgroundColorDarkVar: {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "hex value for background color dark theme",
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "hex value for background color dark theme",
+				ValidateDiagFunc: label_policy_utils.ColorValidation,
 			},
 			warnColorDarkVar: {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "hex value for warn color dark theme",
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "hex value for warn color dark theme",
+				ValidateDiagFunc: label_policy_utils.ColorValidation,
 			},
 			fontColorDarkVar: {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "hex value for font color dark theme",
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "hex value for font color dark theme",
+				ValidateDiagFunc: label_policy_utils.ColorValidation,
 			},
 			disableWatermarkVar: {
 				Type:        schema.TypeBool,
@@ -65,84 +74,130 @@ func GetResource() *schema.Resource {
 				Description: "disable watermark",
 			},
 			LogoPathVar: {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "",
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "local path of the logo to upload",
+				ConflictsWith: []string{LogoContentVar},
+			},
+			LogoContentVar: {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "base64 encoded content of the logo to upload, alternative to " + LogoPathVar,
+				ConflictsWith: []string{LogoPathVar},
 			},
 			LogoHashVar: {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "",
+				Computed:    true,
+				Description: "md5 hash of the logo, computed from the file or content if not set. It is read from the uploaded logo, so changes outside of terraform show up in the plan",
 			},
 			logoURLVar: {
 				Type:        schema.TypeString,
 				Computed:    true,
-				Description: "",
+				Description: "URL of the uploaded logo",
 			},
 			IconPathVar: {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "",
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "local path of the icon to upload",
+				ConflictsWith: []string{IconContentVar},
+			},
+			IconContentVar: {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "base64 encoded content of the icon to upload, alternative to " + IconPathVar,
+				ConflictsWith: []string{IconPathVar},
 			},
 			IconHashVar: {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "",
+				Computed:    true,
+				Description: "md5 hash of the icon, computed from the file or content if not set. It is read from the uploaded icon, so changes outside of terraform show up in the plan",
 			},
 			iconURLVar: {
 				Type:        schema.TypeString,
 				Computed:    true,
-				Description: "",
+				Description: "URL of the uploaded icon",
 			},
 			LogoDarkPathVar: {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "",
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "local path of the logo for the dark theme to upload",
+				ConflictsWith: []string{LogoDarkContentVar},
+			},
+			LogoDarkContentVar: {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "base64 encoded content of the logo for the dark theme to upload, alternative to " + LogoDarkPathVar,
+				ConflictsWith: []string{LogoDarkPathVar},
 			},
 			LogoDarkHashVar: {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "",
+				Computed:    true,
+				Description: "md5 hash of the logo for the dark theme, computed from the file or content if not set. It is read from the uploaded logo for the dark theme, so changes outside of terraform show up in the plan",
 			},
 			logoURLDarkVar: {
 				Type:        schema.TypeString,
 				Computed:    true,
-				Description: "",
+				Description: "URL of the uploaded logo for the dark theme",
 			},
 			IconDarkPathVar: {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "",
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "local path of the icon for the dark theme to upload",
+				ConflictsWith: []string{IconDarkContentVar},
+			},
+			IconDarkContentVar: {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "base64 encoded content of the icon for the dark theme to upload, alternative to " + IconDarkPathVar,
+				ConflictsWith: []string{IconDarkPathVar},
 			},
 			IconDarkHashVar: {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "",
+				Computed:    true,
+				Description: "md5 hash of the icon for the dark theme, computed from the file or content if not set. It is read from the uploaded icon for the dark theme, so changes outside of terraform show up in the plan",
 			},
 			iconURLDarkVar: {
 				Type:        schema.TypeString,
 				Computed:    true,
-				Description: "",
+				Description: "URL of the uploaded icon for the dark theme",
 			},
 			FontPathVar: {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "",
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "local path of the font to upload",
+				ConflictsWith: []string{FontContentVar},
+			},
+			FontContentVar: {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "base64 encoded content of the font to upload, alternative to " + FontPathVar,
+				ConflictsWith: []string{FontPathVar},
 			},
 			FontHashVar: {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "",
+				Computed:    true,
+				Description: "md5 hash of the font, computed from the file or content if not set. It is read from the uploaded font, so changes outside of terraform show up in the plan",
 			},
 			fontURLVar: {
 				Type:        schema.TypeString,
 				Computed:    true,
-				Description: "",
+				Description: "URL of the uploaded font",
 			},
 			SetActiveVar: {
 				Type:        schema.TypeBool,
 				Optional:    true,
-				Description: "set the label policy active after creating/updating",
+				Description: "set the label policy active after creating/updating, disable it to review changes in the preview and activate them with zitadel_label_policy_activation",
+			},
+			ResetToDefaultVar: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "reset the label policy of the organization to the default label policy of the instance when the resource is destroyed, otherwise the custom label policy is kept",
 			},
 			themeModeVar: {
 				Type:        schema.TypeString,
@@ -154,6 +209,7 @@ func GetResource() *schema.Resource {
 				Default: policy.ThemeMode_THEME_MODE_AUTO.String(),
 			},
 		},
+		CustomizeDiff: label_policy_utils.CustomizeDiffHashes(assets),
 		ReadContext:   read,
 		CreateContext: create,
 		DeleteContext: delete,
@@ -53,6 +53,7 @@ func TestAccLabelPolicy(t *testing.T) {
 		label_policy.IconDarkPathVar,
 		label_policy.FontHashVar,
 		label_policy.FontPathVar,
+		label_policy.ResetToDefaultVar,
 	)
 }
 
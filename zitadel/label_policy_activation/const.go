@@ -0,0 +1,5 @@
+package label_policy_activation
+
+const (
+	TriggersVar = "triggers"
+)
@@ -0,0 +1,70 @@
+package label_policy_activation
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/management"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
+)
+
+func delete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "label policy activation cannot be reverted")
+	return nil
+}
+
+func activate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started activate")
+
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return diag.Errorf("failed to get client")
+	}
+
+	client, err := helper.GetManagementClient(ctx, clientinfo)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resp, err := client.ActivateCustomLabelPolicy(helper.CtxWithID(ctx, d), &management.ActivateCustomLabelPolicyRequest{})
+	if err != nil {
+		return diag.Errorf("failed to activate label policy: %v", err)
+	}
+	d.SetId(resp.GetDetails().GetResourceOwner())
+	return nil
+}
+
+func read(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started read")
+
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return diag.Errorf("failed to get client")
+	}
+
+	client, err := helper.GetManagementClient(ctx, clientinfo)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resp, err := client.GetLabelPolicy(helper.CtxWithID(ctx, d), &management.GetLabelPolicyRequest{})
+	if err != nil && helper.IgnoreIfNotFoundError(err) == nil {
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.Errorf("failed to get label policy: %v", err)
+	}
+	if resp.GetPolicy().GetIsDefault() {
+		d.SetId("")
+		return nil
+	}
+	if err := d.Set(helper.OrgIDVar, resp.GetPolicy().GetDetails().GetResourceOwner()); err != nil {
+		return diag.Errorf("failed to set %s of label policy activation: %v", helper.OrgIDVar, err)
+	}
+	d.SetId(resp.GetPolicy().GetDetails().GetResourceOwner())
+	return nil
+}
@@ -0,0 +1,30 @@
+package label_policy_activation
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
+)
+
+func GetResource() *schema.Resource {
+	return &schema.Resource{
+		Description: "Resource activating the previewed custom label policy of an organization. " +
+			"Combined with set_active = false on the label policy, branding changes can be reviewed in the preview before they are activated by this resource.",
+		Schema: map[string]*schema.Schema{
+			helper.OrgIDVar: helper.OrgIDResourceField,
+			TriggersVar: {
+				Type: schema.TypeMap,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Optional:    true,
+				Description: "arbitrary map of values which activate the previewed label policy again when changed",
+			},
+		},
+		ReadContext:   read,
+		CreateContext: activate,
+		UpdateContext: activate,
+		DeleteContext: delete,
+		Importer:      helper.ImportWithOptionalOrg(),
+	}
+}
@@ -0,0 +1,58 @@
+package label_policy_activation_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/management"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper/test_utils"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/label_policy_activation"
+)
+
+func TestAccLabelPolicyActivation(t *testing.T) {
+	frame := test_utils.NewOrgTestFrame(t, "zitadel_label_policy_activation")
+	resourceExample, _ := test_utils.ReadExample(t, test_utils.Resources, frame.ResourceType)
+	exampleProperty := "#5469d4"
+	if _, err := frame.AddCustomLabelPolicy(frame, &management.AddCustomLabelPolicyRequest{PrimaryColor: "#5469d3"}); err != nil {
+		if _, err := frame.UpdateCustomLabelPolicy(frame, &management.UpdateCustomLabelPolicyRequest{PrimaryColor: "#5469d3"}); helper.IgnorePreconditionError(err) != nil {
+			t.Fatalf("failed to prepare label policy: %v", err)
+		}
+	}
+	test_utils.RunLifecyleTest(
+		t,
+		frame.BaseTestFrame,
+		[]string{frame.AsOrgDefaultDependency},
+		test_utils.ReplaceAll(resourceExample, exampleProperty, ""),
+		exampleProperty, "#5469d3",
+		"", "", "",
+		false,
+		checkRemoteProperty(*frame),
+		helper.ZitadelGeneratedIdOnlyRegex,
+		test_utils.CheckNothing,
+		test_utils.ImportOrgId(frame),
+		label_policy_activation.TriggersVar,
+	)
+}
+
+func checkRemoteProperty(frame test_utils.OrgTestFrame) func(string) resource.TestCheckFunc {
+	return func(string) resource.TestCheckFunc {
+		return func(state *terraform.State) error {
+			active, err := frame.GetLabelPolicy(frame, &management.GetLabelPolicyRequest{})
+			if err != nil {
+				return fmt.Errorf("getting policy failed: %w", err)
+			}
+			preview, err := frame.GetPreviewLabelPolicy(frame, &management.GetPreviewLabelPolicyRequest{})
+			if err != nil {
+				return fmt.Errorf("getting preview policy failed: %w", err)
+			}
+			if actual, expect := active.GetPolicy().GetPrimaryColor(), preview.GetPolicy().GetPrimaryColor(); actual != expect {
+				return fmt.Errorf("expected active primary color %s, but got %s", expect, actual)
+			}
+			return nil
+		}
+	}
+}
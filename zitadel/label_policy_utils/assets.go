@@ -0,0 +1,148 @@
+package label_policy_utils
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
+)
+
+// Asset is a file of the label policy, which is either uploaded from a local path or from base64 encoded content
+type Asset struct {
+	Name       string
+	PathVar    string
+	ContentVar string
+	HashVar    string
+	URLVar     string
+	Endpoint   string
+	MIMETypes  []string
+}
+
+type getter interface {
+	Get(string) interface{}
+}
+
+// Content returns the file name and the data of the asset, the data is nil if the asset is not configured
+func (a Asset) Content(d getter) (string, []byte, error) {
+	if content := d.Get(a.ContentVar).(string); content != "" {
+		data, err := base64.StdEncoding.DecodeString(content)
+		if err != nil {
+			return "", nil, fmt.Errorf("failed to decode %s: %w", a.ContentVar, err)
+		}
+		return a.Name, data, nil
+	}
+	if path := d.Get(a.PathVar).(string); path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return "", nil, fmt.Errorf("failed to read %s: %w", a.PathVar, err)
+		}
+		return filepath.Base(path), data, nil
+	}
+	return "", nil, nil
+}
+
+// IsConfigured returns if the asset is configured with a path or content
+func (a Asset) IsConfigured(d getter) bool {
+	return d.Get(a.PathVar).(string) != "" || d.Get(a.ContentVar).(string) != ""
+}
+
+// Upload uploads the configured asset to the label policy of the organization, or of the instance if orgID is empty
+func (a Asset) Upload(ctx context.Context, clientinfo *helper.ClientInfo, d *schema.ResourceData, orgID string) diag.Diagnostics {
+	filename, data, err := a.Content(d)
+	if err != nil {
+		return diag.Errorf("failed to upload %s: %v", a.Name, err)
+	}
+	if data == nil {
+		return nil
+	}
+	if err := a.ValidateMIMEType(data); err != nil {
+		return diag.FromErr(err)
+	}
+	if orgID == "" {
+		return helper.InstanceFormContentPost(ctx, clientinfo, a.Endpoint, filename, data)
+	}
+	return helper.OrgFormContentPost(ctx, clientinfo, a.Endpoint, filename, data, orgID)
+}
+
+// RemoteHash downloads the asset from the URL returned by the label policy and returns its hash
+func (a Asset) RemoteHash(ctx context.Context, clientinfo *helper.ClientInfo, url string) (string, error) {
+	if url == "" {
+		return "", nil
+	}
+	data, err := helper.GetAsset(ctx, clientinfo, url)
+	if err != nil {
+		return "", fmt.Errorf("failed to download %s: %w", a.Name, err)
+	}
+	return Hash(data), nil
+}
+
+// Hash returns the hex encoded md5 sum of the data, which is the same as terraforms filemd5 function returns
+func Hash(data []byte) string {
+	sum := md5.Sum(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// CustomizeDiffHashes computes the hashes of the assets which are configured without a hash,
+// so that changed files as well as assets changed outside of terraform show up in the plan.
+// The hash of an asset which is not configured anymore is emptied, so that the asset is removed.
+func CustomizeDiffHashes(assets []Asset) schema.CustomizeDiffFunc {
+	return func(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+		for _, asset := range assets {
+			if isConfigured(diff, asset.HashVar) {
+				continue
+			}
+			if !diff.NewValueKnown(asset.PathVar) || !diff.NewValueKnown(asset.ContentVar) {
+				if err := diff.SetNewComputed(asset.HashVar); err != nil {
+					return err
+				}
+				continue
+			}
+			_, data, err := asset.Content(diff)
+			if errors.Is(err, fs.ErrNotExist) {
+				// the file might be created during the apply
+				if err := diff.SetNewComputed(asset.HashVar); err != nil {
+					return err
+				}
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			if data == nil {
+				if diff.Get(asset.HashVar).(string) != "" {
+					if err := diff.SetNew(asset.HashVar, ""); err != nil {
+						return err
+					}
+				}
+				continue
+			}
+			if err := asset.ValidateMIMEType(data); err != nil {
+				return err
+			}
+			if hash := Hash(data); hash != diff.Get(asset.HashVar).(string) {
+				if err := diff.SetNew(asset.HashVar, hash); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+}
+
+func isConfigured(diff *schema.ResourceDiff, key string) bool {
+	config := diff.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() {
+		return false
+	}
+	return !config.GetAttr(key).IsNull()
+}
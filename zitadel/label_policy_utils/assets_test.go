@@ -0,0 +1,71 @@
+package label_policy_utils
+
+import (
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+type mapGetter map[string]interface{}
+
+func (m mapGetter) Get(key string) interface{} {
+	return m[key]
+}
+
+func TestAssetContent(t *testing.T) {
+	asset := Asset{Name: "logo", PathVar: "logo_path", ContentVar: "logo_content_base64"}
+	path := filepath.Join(t.TempDir(), "logo.svg")
+	if err := os.WriteFile(path, []byte("<svg/>"), 0600); err != nil {
+		t.Fatalf("writing file failed: %v", err)
+	}
+	tests := []struct {
+		name         string
+		values       mapGetter
+		wantFilename string
+		wantData     string
+		wantErr      bool
+	}{{
+		name:   "not configured",
+		values: mapGetter{"logo_path": "", "logo_content_base64": ""},
+	}, {
+		name:         "from path",
+		values:       mapGetter{"logo_path": path, "logo_content_base64": ""},
+		wantFilename: "logo.svg",
+		wantData:     "<svg/>",
+	}, {
+		name:         "from content",
+		values:       mapGetter{"logo_path": "", "logo_content_base64": base64.StdEncoding.EncodeToString([]byte("<svg/>"))},
+		wantFilename: "logo",
+		wantData:     "<svg/>",
+	}, {
+		name:    "invalid content",
+		values:  mapGetter{"logo_path": "", "logo_content_base64": "not base64!"},
+		wantErr: true,
+	}, {
+		name:    "missing file",
+		values:  mapGetter{"logo_path": filepath.Join(t.TempDir(), "missing.svg"), "logo_content_base64": ""},
+		wantErr: true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filename, data, err := asset.Content(tt.values)
+			if configured := asset.IsConfigured(tt.values); configured != (tt.wantData != "" || tt.wantErr) {
+				t.Errorf("IsConfigured() = %v", configured)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Content() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if filename != tt.wantFilename || string(data) != tt.wantData {
+				t.Errorf("Content() = %s, %s, want %s, %s", filename, data, tt.wantFilename, tt.wantData)
+			}
+		})
+	}
+}
+
+func TestHash(t *testing.T) {
+	// same as filemd5 of a file containing "hello"
+	if got := Hash([]byte("hello")); got != "5d41402abc4b2a76b9719d911017c592" {
+		t.Errorf("Hash() = %s", got)
+	}
+}
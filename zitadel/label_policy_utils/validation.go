@@ -0,0 +1,41 @@
+package label_policy_utils
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/gabriel-vasile/mimetype"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+var (
+	colorRegex = regexp.MustCompile(`^#([0-9a-fA-F]{3,4}|[0-9a-fA-F]{6}|[0-9a-fA-F]{8})$`)
+
+	ImageMIMETypes = []string{"image/png", "image/jpeg", "image/gif", "image/svg+xml", "image/webp", "image/x-icon", "image/vnd.microsoft.icon"}
+	FontMIMETypes  = []string{"font/ttf", "font/otf", "font/woff", "font/woff2", "application/vnd.ms-fontobject"}
+)
+
+// ColorValidation checks that the value is a hex color like #5469d4, empty values use the colors of the login UI
+func ColorValidation(value interface{}, path cty.Path) diag.Diagnostics {
+	color, ok := value.(string)
+	if !ok {
+		return diag.Errorf("value is no string")
+	}
+	if color != "" && !colorRegex.MatchString(color) {
+		return diag.Diagnostics{{Severity: diag.Error, Summary: fmt.Sprintf(`"%s" is no hex color, e.g. #5469d4`, color), AttributePath: path}}
+	}
+	return nil
+}
+
+// ValidateMIMEType checks that the detected type of the data is one of the types ZITADEL accepts for the asset
+func (a Asset) ValidateMIMEType(data []byte) error {
+	detected := mimetype.Detect(data)
+	for _, allowed := range a.MIMETypes {
+		if detected.Is(allowed) {
+			return nil
+		}
+	}
+	return fmt.Errorf("%s has the unsupported type %s, supported types are: %s", a.Name, detected.String(), strings.Join(a.MIMETypes, ", "))
+}
@@ -0,0 +1,62 @@
+package label_policy_utils
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+)
+
+func TestColorValidation(t *testing.T) {
+	tests := []struct {
+		color   string
+		wantErr bool
+	}{
+		{color: "#5469d4"},
+		{color: "#FFF"},
+		{color: "#5469d4cc"},
+		{color: ""},
+		{color: "5469d4", wantErr: true},
+		{color: "#5469d", wantErr: true},
+		{color: "#5469dg", wantErr: true},
+		{color: "red", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.color, func(t *testing.T) {
+			if diags := ColorValidation(tt.color, cty.Path{}); diags.HasError() != tt.wantErr {
+				t.Errorf("ColorValidation() = %v, wantErr %v", diags, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateMIMEType(t *testing.T) {
+	image := Asset{Name: "logo", MIMETypes: ImageMIMETypes}
+	font := Asset{Name: "font", MIMETypes: FontMIMETypes}
+	svg := []byte(`
+<svg height="100" width="100">
+<circle cx="50" cy="50" r="40" stroke="black" stroke-width="3" fill="red" />
+</svg>
+`)
+	png, _ := base64.StdEncoding.DecodeString("iVBORw0KGgoAAAANSUhEUgAAAAEAAAABCAYAAAAfFcSJAAAADUlEQVR42mNk+M9QDwADhgGAWjR9awAAAABJRU5ErkJggg==")
+	ttf, _ := base64.StdEncoding.DecodeString("AAEAAAAHAEAAAgAwY21hcAAJAHYAAAEAAAAALGdseWbxy2aYAAABNAAAAFxoZWFk8jXd+AAAAHwA")
+	tests := []struct {
+		name    string
+		asset   Asset
+		data    []byte
+		wantErr bool
+	}{
+		{name: "svg logo", asset: image, data: svg},
+		{name: "png logo", asset: image, data: png},
+		{name: "text logo", asset: image, data: []byte("just text"), wantErr: true},
+		{name: "ttf font", asset: font, data: ttf},
+		{name: "png font", asset: font, data: png, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.asset.ValidateMIMEType(tt.data); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateMIMEType() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -57,29 +57,39 @@ func GetResource() *schema.Resource {
 				Description: "defines where the user will be redirected to if the login is started without app context (e.g. from mail)",
 			},
 			passwordCheckLifetimeVar: {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "",
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "",
+				ValidateDiagFunc: helper.DurationValidation,
+				DiffSuppressFunc: helper.SuppressEquivalentDurations,
 			},
 			externalLoginCheckLifetimeVar: {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "",
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "",
+				ValidateDiagFunc: helper.DurationValidation,
+				DiffSuppressFunc: helper.SuppressEquivalentDurations,
 			},
 			mfaInitSkipLifetimeVar: {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "",
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "",
+				ValidateDiagFunc: helper.DurationValidation,
+				DiffSuppressFunc: helper.SuppressEquivalentDurations,
 			},
 			secondFactorCheckLifetimeVar: {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "",
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "",
+				ValidateDiagFunc: helper.DurationValidation,
+				DiffSuppressFunc: helper.SuppressEquivalentDurations,
 			},
 			multiFactorCheckLifetimeVar: {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "",
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "",
+				ValidateDiagFunc: helper.DurationValidation,
+				DiffSuppressFunc: helper.SuppressEquivalentDurations,
 			},
 			secondFactorsVar: {
 				Type: schema.TypeSet,
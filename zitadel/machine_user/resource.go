@@ -10,7 +10,7 @@ import (
 )
 
 func GetResource() *schema.Resource {
-	return &schema.Resource{
+	return helper.WithDeletionProtection(&schema.Resource{
 		Description: "Resource representing a serviceaccount situated under an organization, which then can be authorized through memberships or direct grants on other resources.",
 		Schema: map[string]*schema.Schema{
 			helper.OrgIDVar: helper.OrgIDResourceField,
@@ -89,5 +89,5 @@ func GetResource() *schema.Resource {
 			helper.NewImportAttribute(clientIDVar, helper.ConvertNonEmpty, true),
 			helper.NewImportAttribute(clientSecretVar, helper.ConvertNonEmpty, true),
 		),
-	}
+	}, false)
 }
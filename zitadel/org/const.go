@@ -1,13 +1,24 @@
 package org
 
 const (
-	OrgIDVar         = "id"
-	orgIDsVar        = "ids"
-	NameVar          = "name"
-	IsDefaultVar     = "is_default"
-	nameMethodVar    = "name_method"
-	DomainVar        = "domain"
-	domainMethodVar  = "domain_method"
-	stateVar         = "state"
-	primaryDomainVar = "primary_domain"
+	OrgIDVar              = "id"
+	orgIDsVar             = "ids"
+	NameVar               = "name"
+	IsDefaultVar          = "is_default"
+	nameMethodVar         = "name_method"
+	DomainVar             = "domain"
+	domainMethodVar       = "domain_method"
+	stateVar              = "state"
+	primaryDomainVar      = "primary_domain"
+	desiredStateVar       = "desired_state"
+	adminsVar             = "admins"
+	adminUserIDVar        = "user_id"
+	adminRolesVar         = "roles"
+	adminHumanVar         = "human"
+	humanUserNameVar      = "user_name"
+	humanFirstNameVar     = "first_name"
+	humanLastNameVar      = "last_name"
+	humanEmailVar         = "email"
+	humanEmailVerifiedVar = "is_email_verified"
+	humanPasswordVar      = "initial_password"
 )
@@ -19,7 +19,7 @@ func GetDatasource() *schema.Resource {
 				Required:    true,
 				Description: "ID of the organization",
 				ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
-					_, err := helper.ConvertID(i.(string))
+					_, err := helper.ConvertOrgID(i.(string))
 					return diag.FromErr(err)
 				},
 			},
@@ -2,6 +2,9 @@ package org
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -10,6 +13,9 @@ import (
 	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/management"
 	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/object"
 	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/org"
+	orgv2 "github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/org/v2"
+	userv2 "github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/user/v2"
+	"google.golang.org/protobuf/encoding/protojson"
 
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
 )
@@ -44,13 +50,21 @@ func create(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Dia
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	resp, err := client.AddOrg(ctx, &management.AddOrgRequest{
-		Name: d.Get(NameVar).(string),
-	})
-	if err != nil {
-		return diag.FromErr(err)
+	var orgId string
+	if customID, admins := d.Get(helper.OrgIDVar).(string), d.Get(adminsVar).([]interface{}); customID != "" || len(admins) > 0 {
+		orgId, err = addOrgV2(ctx, clientinfo, d, customID, admins)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	} else {
+		resp, err := client.AddOrg(ctx, &management.AddOrgRequest{
+			Name: d.Get(NameVar).(string),
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		orgId = resp.GetId()
 	}
-	orgId := resp.GetId()
 	d.SetId(orgId)
 	if val, ok := d.GetOk(IsDefaultVar); ok && val.(bool) {
 		adminClient, err := helper.GetAdminClient(ctx, clientinfo)
@@ -64,9 +78,108 @@ func create(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Dia
 			return diag.Errorf("error while setting default org id %s: %v", orgId, err)
 		}
 	}
+	if d.Get(desiredStateVar).(string) == org.OrgState_ORG_STATE_INACTIVE.String() {
+		if _, err := client.DeactivateOrg(helper.CtxSetOrgID(ctx, orgId), &management.DeactivateOrgRequest{}); err != nil {
+			return diag.Errorf("failed to deactivate org: %v", err)
+		}
+	}
 	return nil
 }
 
+// addOrgV2 creates the org with the org v2 API, which adds the admins in the same request.
+// The org v2 client has no field for a custom org ID yet, so the request is sent to the REST API if one is set.
+func addOrgV2(ctx context.Context, clientinfo *helper.ClientInfo, d *schema.ResourceData, customID string, admins []interface{}) (string, error) {
+	req := &orgv2.AddOrganizationRequest{
+		Name:   d.Get(NameVar).(string),
+		Admins: make([]*orgv2.AddOrganizationRequest_Admin, len(admins)),
+	}
+	for i, adminConfig := range admins {
+		adminMap := adminConfig.(map[string]interface{})
+		req.Admins[i] = &orgv2.AddOrganizationRequest_Admin{
+			Roles: helper.SetToStringSlice(adminMap[adminRolesVar].(*schema.Set)),
+		}
+		userID := adminMap[adminUserIDVar].(string)
+		humans := adminMap[adminHumanVar].([]interface{})
+		if (userID == "") == (len(humans) == 0) {
+			return "", fmt.Errorf("either %s or %s must be set for each of the %s", adminUserIDVar, adminHumanVar, adminsVar)
+		}
+		if userID != "" {
+			req.Admins[i].UserType = &orgv2.AddOrganizationRequest_Admin_UserId{UserId: userID}
+			continue
+		}
+		req.Admins[i].UserType = &orgv2.AddOrganizationRequest_Admin_Human{Human: humanAdmin(humans[0].(map[string]interface{}))}
+	}
+
+	resp := &orgv2.AddOrganizationResponse{}
+	if customID == "" {
+		client, err := helper.GetOrgV2Client(ctx, clientinfo)
+		if err != nil {
+			return "", err
+		}
+		resp, err = client.AddOrganization(ctx, req)
+		if err != nil {
+			return "", fmt.Errorf("failed to create org: %w", err)
+		}
+	} else {
+		body := make(map[string]interface{})
+		data, err := protojson.Marshal(req)
+		if err != nil {
+			return "", fmt.Errorf("failed to marshal org: %w", err)
+		}
+		if err := json.Unmarshal(data, &body); err != nil {
+			return "", fmt.Errorf("failed to marshal org: %w", err)
+		}
+		body["orgId"] = customID
+		var respData json.RawMessage
+		if err := helper.RESTCall(ctx, clientinfo, http.MethodPost, "/v2/organizations", body, &respData); err != nil {
+			return "", fmt.Errorf("failed to create org: %w", err)
+		}
+		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(respData, resp); err != nil {
+			return "", fmt.Errorf("failed to unmarshal created org: %w", err)
+		}
+	}
+
+	setCreatedAdminIDs(admins, resp.GetCreatedAdmins())
+	if err := d.Set(adminsVar, admins); err != nil {
+		return "", fmt.Errorf("failed to set %s of org: %w", adminsVar, err)
+	}
+	return resp.GetOrganizationId(), nil
+}
+
+// setCreatedAdminIDs sets the IDs of the created human users, which are returned in the order of the human admins
+func setCreatedAdminIDs(admins []interface{}, createdAdmins []*orgv2.AddOrganizationResponse_CreatedAdmin) {
+	for _, adminConfig := range admins {
+		adminMap := adminConfig.(map[string]interface{})
+		if adminMap[adminUserIDVar].(string) != "" || len(createdAdmins) == 0 {
+			continue
+		}
+		adminMap[adminUserIDVar] = createdAdmins[0].GetUserId()
+		createdAdmins = createdAdmins[1:]
+	}
+}
+
+func humanAdmin(human map[string]interface{}) *userv2.AddHumanUserRequest {
+	req := &userv2.AddHumanUserRequest{
+		Profile: &userv2.SetHumanProfile{
+			GivenName:  human[humanFirstNameVar].(string),
+			FamilyName: human[humanLastNameVar].(string),
+		},
+		Email: &userv2.SetHumanEmail{
+			Email: human[humanEmailVar].(string),
+		},
+	}
+	if userName := human[humanUserNameVar].(string); userName != "" {
+		req.Username = &userName
+	}
+	if human[humanEmailVerifiedVar].(bool) {
+		req.Email.Verification = &userv2.SetHumanEmail_IsVerified{IsVerified: true}
+	}
+	if password := human[humanPasswordVar].(string); password != "" {
+		req.PasswordType = &userv2.AddHumanUserRequest_Password{Password: &userv2.Password{Password: password, ChangeRequired: true}}
+	}
+	return req
+}
+
 func update(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	tflog.Info(ctx, "started update")
 	clientinfo, ok := m.(*helper.ClientInfo)
@@ -100,6 +213,23 @@ func update(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Dia
 			return diag.Errorf("error while setting default org id %s: %v", d.Id(), err)
 		}
 	}
+	// the state is read before the update, so an org that already is in the desired state is left untouched
+	if desiredState := d.Get(desiredStateVar).(string); d.HasChange(desiredStateVar) && desiredState != "" && desiredState != d.Get(stateVar).(string) {
+		client, err := helper.GetManagementClient(ctx, clientinfo)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		switch desiredState {
+		case org.OrgState_ORG_STATE_ACTIVE.String():
+			_, err = client.ReactivateOrg(helper.CtxSetOrgID(ctx, d.Id()), &management.ReactivateOrgRequest{})
+		case org.OrgState_ORG_STATE_INACTIVE.String():
+			_, err = client.DeactivateOrg(helper.CtxSetOrgID(ctx, d.Id()), &management.DeactivateOrgRequest{})
+		}
+		// the remote state may have changed since it was read
+		if err := helper.IgnorePreconditionError(err); err != nil {
+			return diag.Errorf("failed to change state of org to %s: %v", desiredState, err)
+		}
+	}
 	return nil
 }
 
@@ -122,6 +252,12 @@ func get(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagno
 	}
 	remoteOrg := resp.GetOrg()
 	d.SetId(remoteOrg.Id)
+	// The datasource shares this function but has no custom org ID.
+	if _, ok := d.Get(helper.OrgIDVar).(string); ok {
+		if err := d.Set(helper.OrgIDVar, remoteOrg.Id); err != nil {
+			return diag.Errorf("error while setting org_id %s: %v", remoteOrg.Id, err)
+		}
+	}
 	if err := d.Set(NameVar, remoteOrg.Name); err != nil {
 		return diag.Errorf("error while setting org name %s: %v", remoteOrg.Name, err)
 	}
@@ -132,6 +268,13 @@ func get(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagno
 	if err := d.Set(stateVar, state); err != nil {
 		return diag.Errorf("error while setting org state %s: %v", state, err)
 	}
+	// the desired state is only managed if it is configured, a differing remote state results in a diff.
+	// The datasource shares this function but has no desired state.
+	if desiredState, ok := d.Get(desiredStateVar).(string); ok && desiredState != "" {
+		if err := d.Set(desiredStateVar, state); err != nil {
+			return diag.Errorf("error while setting org desired_state %s: %v", state, err)
+		}
+	}
 	adminClient, err := helper.GetAdminClient(ctx, clientinfo)
 	if err != nil {
 		return diag.FromErr(err)
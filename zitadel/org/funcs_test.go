@@ -0,0 +1,109 @@
+package org
+
+import (
+	"reflect"
+	"testing"
+
+	orgv2 "github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/org/v2"
+	userv2 "github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/user/v2"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestHumanAdmin(t *testing.T) {
+	userName := "jane"
+	tests := []struct {
+		name   string
+		human  map[string]interface{}
+		expect *userv2.AddHumanUserRequest
+	}{{
+		name: "required fields only",
+		human: map[string]interface{}{
+			humanUserNameVar:      "",
+			humanFirstNameVar:     "Jane",
+			humanLastNameVar:      "Doe",
+			humanEmailVar:         "jane@example.com",
+			humanEmailVerifiedVar: false,
+			humanPasswordVar:      "",
+		},
+		expect: &userv2.AddHumanUserRequest{
+			Profile: &userv2.SetHumanProfile{GivenName: "Jane", FamilyName: "Doe"},
+			Email:   &userv2.SetHumanEmail{Email: "jane@example.com"},
+		},
+	}, {
+		name: "all fields",
+		human: map[string]interface{}{
+			humanUserNameVar:      userName,
+			humanFirstNameVar:     "Jane",
+			humanLastNameVar:      "Doe",
+			humanEmailVar:         "jane@example.com",
+			humanEmailVerifiedVar: true,
+			humanPasswordVar:      "Password1!",
+		},
+		expect: &userv2.AddHumanUserRequest{
+			Username: &userName,
+			Profile:  &userv2.SetHumanProfile{GivenName: "Jane", FamilyName: "Doe"},
+			Email: &userv2.SetHumanEmail{
+				Email:        "jane@example.com",
+				Verification: &userv2.SetHumanEmail_IsVerified{IsVerified: true},
+			},
+			PasswordType: &userv2.AddHumanUserRequest_Password{Password: &userv2.Password{Password: "Password1!", ChangeRequired: true}},
+		},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if actual := humanAdmin(tt.human); !proto.Equal(actual, tt.expect) {
+				t.Errorf("humanAdmin() = %v, want %v", actual, tt.expect)
+			}
+		})
+	}
+}
+
+func TestSetCreatedAdminIDs(t *testing.T) {
+	admin := func(userID string) map[string]interface{} {
+		return map[string]interface{}{adminUserIDVar: userID}
+	}
+	created := func(userIDs ...string) []*orgv2.AddOrganizationResponse_CreatedAdmin {
+		createdAdmins := make([]*orgv2.AddOrganizationResponse_CreatedAdmin, len(userIDs))
+		for i, userID := range userIDs {
+			createdAdmins[i] = &orgv2.AddOrganizationResponse_CreatedAdmin{UserId: userID}
+		}
+		return createdAdmins
+	}
+	tests := []struct {
+		name          string
+		admins        []interface{}
+		createdAdmins []*orgv2.AddOrganizationResponse_CreatedAdmin
+		expect        []string
+	}{{
+		name:   "existing users only",
+		admins: []interface{}{admin("existing1"), admin("existing2")},
+		expect: []string{"existing1", "existing2"},
+	}, {
+		name:          "human users only",
+		admins:        []interface{}{admin(""), admin("")},
+		createdAdmins: created("created1", "created2"),
+		expect:        []string{"created1", "created2"},
+	}, {
+		name:          "created IDs are assigned in the order of the human admins",
+		admins:        []interface{}{admin(""), admin("existing"), admin("")},
+		createdAdmins: created("created1", "created2"),
+		expect:        []string{"created1", "existing", "created2"},
+	}, {
+		name:          "missing created IDs are left empty",
+		admins:        []interface{}{admin(""), admin("")},
+		createdAdmins: created("created1"),
+		expect:        []string{"created1", ""},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setCreatedAdminIDs(tt.admins, tt.createdAdmins)
+			actual := make([]string, len(tt.admins))
+			for i, adminConfig := range tt.admins {
+				actual[i] = adminConfig.(map[string]interface{})[adminUserIDVar].(string)
+			}
+			if !reflect.DeepEqual(actual, tt.expect) {
+				t.Errorf("setCreatedAdminIDs() = %v, want %v", actual, tt.expect)
+			}
+		})
+	}
+}
@@ -1,13 +1,16 @@
 package org
 
 import (
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/org"
 
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
 )
 
 func GetResource() *schema.Resource {
-	return &schema.Resource{
+	return helper.WithDeletionProtection(&schema.Resource{
 		Description: "Resource representing an organization in ZITADEL, which is the highest level after the instance and contains several other resource including policies if the configuration differs to the default policies on the instance.",
 		Schema: map[string]*schema.Schema{
 			NameVar: {
@@ -36,11 +39,115 @@ func GetResource() *schema.Resource {
 				Computed:    true,
 				Description: "State of the org",
 			},
+			desiredStateVar: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Deactivates or reactivates the org, if not set, the state of the org isn't managed" + helper.DescriptionEnumValuesList(desiredStates()),
+				ValidateDiagFunc: func(value interface{}, path cty.Path) diag.Diagnostics {
+					return helper.EnumValueValidation(desiredStateVar, value, helper.EnumValueMap(desiredStates()))
+				},
+			},
+			helper.OrgIDVar: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Custom ID of the org, which keeps it stable across environments. If not set, ZITADEL generates the ID.",
+				ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
+					_, err := helper.ConvertOrgID(i.(string))
+					return diag.FromErr(err)
+				},
+			},
+			adminsVar: {
+				Type:             schema.TypeList,
+				Optional:         true,
+				DiffSuppressFunc: suppressAfterCreate,
+				Description:      "Admins added when the org is created, either existing users or new human users. Changes after the creation are ignored, use zitadel_org_member to manage the members of existing orgs.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						adminUserIDVar: {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							DiffSuppressFunc: suppressAfterCreate,
+							Description:      "ID of an existing user, for new human users the ID of the created user",
+						},
+						adminRolesVar: {
+							Type:             schema.TypeSet,
+							Optional:         true,
+							Elem:             &schema.Schema{Type: schema.TypeString},
+							DiffSuppressFunc: suppressAfterCreate,
+							Description:      "Org member roles of the admin, ORG_OWNER if not set",
+						},
+						adminHumanVar: {
+							Type:             schema.TypeList,
+							Optional:         true,
+							MaxItems:         1,
+							DiffSuppressFunc: suppressAfterCreate,
+							Description:      "New human user, which is created with the org",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									humanUserNameVar: {
+										Type:             schema.TypeString,
+										Optional:         true,
+										DiffSuppressFunc: suppressAfterCreate,
+										Description:      "Username of the user, the email is used if not set",
+									},
+									humanFirstNameVar: {
+										Type:             schema.TypeString,
+										Required:         true,
+										DiffSuppressFunc: suppressAfterCreate,
+										Description:      "First name of the user",
+									},
+									humanLastNameVar: {
+										Type:             schema.TypeString,
+										Required:         true,
+										DiffSuppressFunc: suppressAfterCreate,
+										Description:      "Last name of the user",
+									},
+									humanEmailVar: {
+										Type:             schema.TypeString,
+										Required:         true,
+										DiffSuppressFunc: suppressAfterCreate,
+										Description:      "Email of the user",
+									},
+									humanEmailVerifiedVar: {
+										Type:             schema.TypeBool,
+										Optional:         true,
+										DiffSuppressFunc: suppressAfterCreate,
+										Description:      "Is the email verified, if false, a verification email is sent",
+									},
+									humanPasswordVar: {
+										Type:             schema.TypeString,
+										Optional:         true,
+										Sensitive:        true,
+										DiffSuppressFunc: suppressAfterCreate,
+										Description:      "Initial password of the user, which has to be changed on the first login",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 		},
 		CreateContext: create,
 		DeleteContext: delete,
 		ReadContext:   get,
 		UpdateContext: update,
-		Importer:      helper.ImportWithID(OrgIDVar),
+		Importer:      helper.ImportWithAttributes(helper.NewImportAttribute(OrgIDVar, helper.ConvertOrgID, false)),
+	}, true)
+}
+
+// suppressAfterCreate ignores changes of the admins, as they are only added when the org is created
+func suppressAfterCreate(_, _, _ string, d *schema.ResourceData) bool {
+	return d.Id() != ""
+}
+
+// desiredStates returns the states an org can be set to
+func desiredStates() map[int32]string {
+	return map[int32]string{
+		int32(org.OrgState_ORG_STATE_ACTIVE):   org.OrgState_ORG_STATE_ACTIVE.String(),
+		int32(org.OrgState_ORG_STATE_INACTIVE): org.OrgState_ORG_STATE_INACTIVE.String(),
 	}
 }
@@ -1,9 +1,15 @@
 package org_test
 
 import (
+	"fmt"
+	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/admin"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/management"
+	orgpb "github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/org"
 
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper/test_utils"
@@ -20,7 +26,10 @@ func TestAccOrg(t *testing.T) {
 		t,
 		frame.BaseTestFrame,
 		nil,
-		test_utils.ReplaceAll(resourceExample, exampleProperty, ""),
+		func(property, secret string) string {
+			// the org must be removable by the test
+			return strings.Replace(test_utils.ReplaceAll(resourceExample, exampleProperty, "")(property, secret), "}", fmt.Sprintf("  %s = false\n}", helper.DeletionProtectionVar), 1)
+		},
 		initialProperty, updatedProperty,
 		"", "", "",
 		false,
@@ -28,6 +37,7 @@ func TestAccOrg(t *testing.T) {
 		helper.ZitadelGeneratedIdOnlyRegex,
 		test_utils.CheckIsNotFoundFromPropertyCheck(checkRemoteProperty(frame, idFromState(frame)), updatedProperty),
 		test_utils.ImportResourceId(frame.BaseTestFrame),
+		helper.DeletionProtectionVar,
 	)
 }
 
@@ -36,3 +46,119 @@ func idFromState(frame *test_utils.OrgTestFrame) func(*terraform.State) string {
 		return frame.State(state).ID
 	}
 }
+
+func TestAccOrgDesiredState(t *testing.T) {
+	frame := test_utils.NewOrgTestFrame(t, "zitadel_org")
+	config := func(desiredState string) string {
+		stateAttribute := ""
+		if desiredState != "" {
+			stateAttribute = fmt.Sprintf("desired_state = \"%s\"", desiredState)
+		}
+		return fmt.Sprintf(`%s
+resource "zitadel_org" "default" {
+  name                = "desiredstate_%s"
+  deletion_protection = false
+  %s
+}`, frame.ProviderSnippet, frame.UniqueResourcesID, stateAttribute)
+	}
+	resource.ParallelTest(t, resource.TestCase{
+		Steps: []resource.TestStep{{ // The org is active after creation
+			Config: config(""),
+			Check:  checkRemoteState(frame, orgpb.OrgState_ORG_STATE_ACTIVE),
+		}, { // Managing the state of an org that already is in the desired state doesn't call ZITADEL
+			Config: config(orgpb.OrgState_ORG_STATE_ACTIVE.String()),
+			Check:  checkRemoteState(frame, orgpb.OrgState_ORG_STATE_ACTIVE),
+		}, {
+			Config: config(orgpb.OrgState_ORG_STATE_INACTIVE.String()),
+			Check:  checkRemoteState(frame, orgpb.OrgState_ORG_STATE_INACTIVE),
+		}, {
+			Config: config(orgpb.OrgState_ORG_STATE_ACTIVE.String()),
+			Check:  checkRemoteState(frame, orgpb.OrgState_ORG_STATE_ACTIVE),
+		}},
+		ProtoV6ProviderFactories: frame.ProtoV6ProviderFactories(),
+	})
+}
+
+func checkRemoteState(frame *test_utils.OrgTestFrame, expect orgpb.OrgState) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		remoteResource, err := frame.Admin.GetOrgByID(frame, &admin.GetOrgByIDRequest{Id: frame.State(state).ID})
+		if err != nil {
+			return err
+		}
+		if actual := remoteResource.GetOrg().GetState(); actual != expect {
+			return fmt.Errorf("expected state %s, but got %s", expect, actual)
+		}
+		return nil
+	}
+}
+
+func TestAccOrgWithCustomIDAndAdmins(t *testing.T) {
+	frame := test_utils.NewOrgTestFrame(t, "zitadel_org")
+	customID := "tenant-" + strings.ToLower(frame.UniqueResourcesID)
+	config := fmt.Sprintf(`%s
+%s
+resource "zitadel_machine_user" "admin" {
+  org_id    = data.zitadel_org.default.id
+  user_name = "orgadmin_%[3]s@example.com"
+  name      = "org admin"
+}
+
+resource "zitadel_org" "default" {
+  name                = "customid_%[3]s"
+  org_id              = "%[4]s"
+  deletion_protection = false
+  admins {
+    user_id = zitadel_machine_user.admin.id
+    roles   = ["ORG_OWNER"]
+  }
+  admins {
+    human {
+      first_name        = "Jane"
+      last_name         = "Doe"
+      email             = "jane_%[3]s@example.com"
+      is_email_verified = true
+    }
+  }
+}`, frame.ProviderSnippet, frame.AsOrgDefaultDependency, frame.UniqueResourcesID, customID)
+	resource.ParallelTest(t, resource.TestCase{
+		Steps: []resource.TestStep{{
+			Config: config,
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr(frame.TerraformName, "id", customID),
+				resource.TestCheckResourceAttrPair(frame.TerraformName, "admins.0.user_id", "zitadel_machine_user.admin", "id"),
+				resource.TestCheckResourceAttrSet(frame.TerraformName, "admins.1.user_id"),
+				checkRemoteProperty(frame, func(*terraform.State) string { return customID })("customid_"+frame.UniqueResourcesID),
+				checkRemoteMembers(frame, customID),
+			),
+		}, {
+			Config:                  config,
+			ResourceName:            frame.TerraformName,
+			ImportState:             true,
+			ImportStateId:           customID,
+			ImportStateVerify:       true,
+			ImportStateVerifyIgnore: []string{"admins", helper.DeletionProtectionVar},
+		}},
+		ProtoV6ProviderFactories: frame.ProtoV6ProviderFactories(),
+	})
+}
+
+// checkRemoteMembers checks that both admins from the state are members of the org
+func checkRemoteMembers(frame *test_utils.OrgTestFrame, orgID string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		resp, err := frame.ListOrgMembers(helper.CtxSetOrgID(frame, orgID), &management.ListOrgMembersRequest{})
+		if err != nil {
+			return err
+		}
+		members := make(map[string]bool, len(resp.GetResult()))
+		for _, orgMember := range resp.GetResult() {
+			members[orgMember.GetUserId()] = true
+		}
+		attributes := frame.State(state).Attributes
+		for _, key := range []string{"admins.0.user_id", "admins.1.user_id"} {
+			if userID := attributes[key]; !members[userID] {
+				return fmt.Errorf("expected %s %s to be a member of the org, but got %v", key, userID, resp.GetResult())
+			}
+		}
+		return nil
+	}
+}
@@ -11,7 +11,7 @@ import (
 )
 
 func GetResource() *schema.Resource {
-	return &schema.Resource{
+	return helper.WithDeletionProtection(&schema.Resource{
 		Description: "Resource representing a generic JWT IdP of the organization.",
 		Schema: map[string]*schema.Schema{
 			helper.OrgIDVar: helper.OrgIDResourceField,
@@ -59,5 +59,5 @@ func GetResource() *schema.Resource {
 		UpdateContext: update,
 		DeleteContext: delete,
 		Importer:      helper.ImportWithIDAndOptionalOrg(idp_utils.IdpIDVar),
-	}
+	}, false)
 }
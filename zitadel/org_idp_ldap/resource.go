@@ -10,7 +10,7 @@ import (
 )
 
 func GetResource() *schema.Resource {
-	return &schema.Resource{
+	return helper.WithDeletionProtection(&schema.Resource{
 		Description: "Resource representing an LDAP IdP on the organization.",
 		Schema: map[string]*schema.Schema{
 			helper.OrgIDVar:                helper.OrgIDResourceField,
@@ -50,5 +50,5 @@ func GetResource() *schema.Resource {
 		CreateContext: create,
 		DeleteContext: org_idp_utils.Delete,
 		Importer:      helper.ImportWithIDAndOptionalOrgAndSecret(idp_utils.IdpIDVar, idp_ldap.BindPasswordVar),
-	}
+	}, false)
 }
@@ -27,7 +27,7 @@ func create(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Dia
 		Scopes:           idp_utils.ScopesValue(d),
 		ProviderOptions:  idp_utils.ProviderOptionsValue(d),
 		Issuer:           idp_utils.StringValue(d, IssuerVar),
-		IsIdTokenMapping: idp_utils.BoolValue(d, IsIdTokenMappingVar),
+		IsIdTokenMapping: idp_utils.BoolValue(d, idp_utils.IsIdTokenMappingVar),
 	})
 	if err != nil {
 		return diag.Errorf("failed to create oidc idp: %v", err)
@@ -53,7 +53,7 @@ func update(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Dia
 		ClientSecret:     idp_utils.StringValue(d, idp_utils.ClientSecretVar),
 		Scopes:           idp_utils.ScopesValue(d),
 		ProviderOptions:  idp_utils.ProviderOptionsValue(d),
-		IsIdTokenMapping: idp_utils.BoolValue(d, IsIdTokenMappingVar),
+		IsIdTokenMapping: idp_utils.BoolValue(d, idp_utils.IsIdTokenMappingVar),
 	})
 	if err != nil {
 		return diag.Errorf("failed to update idp: %v", err)
@@ -94,7 +94,7 @@ func read(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagn
 		idp_utils.IsAutoUpdateVar:      generalCfg.GetIsAutoUpdate(),
 		idp_utils.AutoLinkingVar:       idp_utils.AutoLinkingString(generalCfg.GetAutoLinking()),
 		IssuerVar:                      specificCfg.GetIssuer(),
-		IsIdTokenMappingVar:            specificCfg.GetIsIdTokenMapping(),
+		idp_utils.IsIdTokenMappingVar:  specificCfg.GetIsIdTokenMapping(),
 	}
 	for k, v := range set {
 		if err := d.Set(k, v); err != nil {
@@ -9,7 +9,7 @@ import (
 )
 
 func GetResource() *schema.Resource {
-	return &schema.Resource{
+	return helper.WithDeletionProtection(&schema.Resource{
 		Description: "Resource representing a generic OIDC IdP on the organization.",
 		Schema: map[string]*schema.Schema{
 			helper.OrgIDVar:                helper.OrgIDResourceField,
@@ -23,12 +23,12 @@ func GetResource() *schema.Resource {
 			idp_utils.IsAutoUpdateVar:      idp_utils.IsAutoUpdateResourceField,
 			idp_utils.AutoLinkingVar:       idp_utils.AutoLinkingResourceField,
 			IssuerVar:                      IssuerResourceField,
-			IsIdTokenMappingVar:            IsIdTokenMappingResourceField,
+			idp_utils.IsIdTokenMappingVar:  idp_utils.IsIdTokenMappingResourceField,
 		},
 		ReadContext:   read,
 		UpdateContext: update,
 		CreateContext: create,
 		DeleteContext: org_idp_utils.Delete,
 		Importer:      helper.ImportWithIDAndOptionalOrgAndSecret(idp_utils.IdpIDVar, idp_utils.ClientSecretVar),
-	}
+	}, false)
 }
@@ -10,24 +10,26 @@ import (
 )
 
 func GetResource() *schema.Resource {
-	return &schema.Resource{
+	return helper.WithDeletionProtection(&schema.Resource{
 		Description: "Resource representing a SAML IdP on the organization.",
 		Schema: map[string]*schema.Schema{
-			helper.OrgIDVar:                helper.OrgIDResourceField,
-			idp_utils.NameVar:              idp_utils.NameResourceField,
-			idp_saml.BindingVar:            idp_saml.BindingResourceField,
-			idp_saml.MetadataXMLVar:        idp_saml.MetadataXMLResourceField,
-			idp_saml.WithSignedRequestVar:  idp_saml.WithSignedRequestResourceField,
-			idp_utils.IsLinkingAllowedVar:  idp_utils.IsLinkingAllowedResourceField,
-			idp_utils.IsCreationAllowedVar: idp_utils.IsCreationAllowedResourceField,
-			idp_utils.IsAutoCreationVar:    idp_utils.IsAutoCreationResourceField,
-			idp_utils.IsAutoUpdateVar:      idp_utils.IsAutoUpdateResourceField,
-			idp_utils.AutoLinkingVar:       idp_utils.AutoLinkingResourceField,
+			helper.OrgIDVar:                            helper.OrgIDResourceField,
+			idp_utils.NameVar:                          idp_utils.NameResourceField,
+			idp_saml.BindingVar:                        idp_saml.BindingResourceField,
+			idp_saml.MetadataXMLVar:                    idp_saml.MetadataXMLResourceField,
+			idp_utils.WithSignedRequestVar:             idp_utils.WithSignedRequestResourceField,
+			idp_utils.NameIDFormatVar:                  idp_utils.NameIDFormatResourceField,
+			idp_utils.TransientMappingAttributeNameVar: idp_utils.TransientMappingAttributeNameResourceField,
+			idp_utils.IsLinkingAllowedVar:              idp_utils.IsLinkingAllowedResourceField,
+			idp_utils.IsCreationAllowedVar:             idp_utils.IsCreationAllowedResourceField,
+			idp_utils.IsAutoCreationVar:                idp_utils.IsAutoCreationResourceField,
+			idp_utils.IsAutoUpdateVar:                  idp_utils.IsAutoUpdateResourceField,
+			idp_utils.AutoLinkingVar:                   idp_utils.AutoLinkingResourceField,
 		},
 		ReadContext:   read,
 		UpdateContext: update,
 		CreateContext: create,
 		DeleteContext: org_idp_utils.Delete,
 		Importer:      helper.ImportWithIDAndOptionalOrg(idp_utils.IdpIDVar),
-	}
+	}, false)
 }
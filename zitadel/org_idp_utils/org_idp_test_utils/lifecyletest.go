@@ -12,6 +12,12 @@ import (
 )
 
 func RunOrgLifecyleTest(t *testing.T, resourceName, secretAttribute string) {
+	RunOrgLifecyleTestWithUpdatedSecret(t, resourceName, secretAttribute, "an_updated_secret")
+}
+
+// RunOrgLifecyleTestWithUpdatedSecret is like RunOrgLifecyleTest, but updates the secret to the given value,
+// which is needed for secrets that are validated
+func RunOrgLifecyleTestWithUpdatedSecret(t *testing.T, resourceName, secretAttribute, updatedSecret string) {
 	frame := test_utils.NewOrgTestFrame(t, resourceName)
 	resourceExample, exampleAttributes := test_utils.ReadExample(t, test_utils.Resources, frame.ResourceType)
 	nameProperty := test_utils.AttributeValue(t, idp_utils.NameVar, exampleAttributes).AsString()
@@ -33,7 +39,7 @@ func RunOrgLifecyleTest(t *testing.T, resourceName, secretAttribute string) {
 		[]string{frame.AsOrgDefaultDependency},
 		test_utils.ReplaceAll(resourceExample, exampleProperty, exampleSecret),
 		true, false,
-		secretAttribute, exampleSecret, "an_updated_secret",
+		secretAttribute, exampleSecret, updatedSecret,
 		false,
 		CheckCreationAllowed(*frame),
 		helper.ZitadelGeneratedIdOnlyRegex,
@@ -0,0 +1,5 @@
+package org_primary_domain
+
+const (
+	DomainVar = "domain"
+)
@@ -0,0 +1,109 @@
+package org_primary_domain
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/management"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/object"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/org"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
+)
+
+func delete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started delete")
+	tflog.Info(ctx, "an organization always has a primary domain, it is only removed from the state")
+	return nil
+}
+
+func update(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started update")
+
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return diag.Errorf("failed to get client")
+	}
+
+	client, err := helper.GetManagementClient(ctx, clientinfo)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	domainName := d.Get(DomainVar).(string)
+	resp, err := client.ListOrgDomains(helper.CtxWithOrgID(ctx, d), &management.ListOrgDomainsRequest{
+		Queries: []*org.DomainSearchQuery{{
+			Query: &org.DomainSearchQuery_DomainNameQuery{
+				DomainNameQuery: &org.DomainNameQuery{
+					Name:   domainName,
+					Method: object.TextQueryMethod_TEXT_QUERY_METHOD_EQUALS,
+				},
+			},
+		}},
+	})
+	if err != nil {
+		return diag.Errorf("failed to list domains: %v", err)
+	}
+	var domain *org.Domain
+	for _, result := range resp.GetResult() {
+		if result.GetDomainName() == domainName {
+			domain = result
+		}
+	}
+	if domain == nil {
+		return diag.Errorf("domain %s not found", domainName)
+	}
+	if !domain.GetIsVerified() {
+		return diag.Errorf("domain %s is not verified and can't be set as primary domain", domainName)
+	}
+	if !domain.GetIsPrimary() {
+		if _, err := client.SetPrimaryOrgDomain(helper.CtxWithOrgID(ctx, d), &management.SetPrimaryOrgDomainRequest{Domain: domainName}); err != nil {
+			return diag.Errorf("failed to set primary domain: %v", err)
+		}
+	}
+	d.SetId(domain.GetOrgId())
+	return nil
+}
+
+func read(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started read")
+
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return diag.Errorf("failed to get client")
+	}
+
+	client, err := helper.GetManagementClient(ctx, clientinfo)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resp, err := client.ListOrgDomains(helper.CtxWithOrgID(ctx, d), &management.ListOrgDomainsRequest{})
+	if err != nil && helper.IgnoreIfNotFoundError(err) == nil {
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.Errorf("failed to list domains: %v", err)
+	}
+	for _, domain := range resp.GetResult() {
+		if !domain.GetIsPrimary() {
+			continue
+		}
+		set := map[string]interface{}{
+			helper.OrgIDVar: domain.GetOrgId(),
+			DomainVar:       domain.GetDomainName(),
+		}
+		for k, v := range set {
+			if err := d.Set(k, v); err != nil {
+				return diag.Errorf("failed to set %s of primary domain: %v", k, err)
+			}
+		}
+		d.SetId(domain.GetOrgId())
+		return nil
+	}
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,28 @@
+package org_primary_domain
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
+)
+
+func GetResource() *schema.Resource {
+	return &schema.Resource{
+		Description: "Resource representing the primary domain of an organization. " +
+			"It authoritatively sets the primary domain, so it shouldn't be combined with is_primary on zitadel_domain resources of the same organization. " +
+			"Only verified domains can be set as primary domain.",
+		Schema: map[string]*schema.Schema{
+			helper.OrgIDVar: helper.OrgIDResourceField,
+			DomainVar: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the verified domain, which is set as primary domain",
+			},
+		},
+		CreateContext: update,
+		UpdateContext: update,
+		DeleteContext: delete,
+		ReadContext:   read,
+		Importer:      helper.ImportWithOptionalOrg(),
+	}
+}
@@ -0,0 +1,66 @@
+package org_primary_domain_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/admin"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/management"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper/test_utils"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/org_primary_domain"
+)
+
+func TestAccOrgPrimaryDomain(t *testing.T) {
+	frame := test_utils.NewOrgTestFrame(t, "zitadel_org_primary_domain")
+	otherFrame := frame.AnotherOrg(t, "primary-domain-org-"+frame.UniqueResourcesID)
+	resourceExample, exampleAttributes := test_utils.ReadExample(t, test_utils.Resources, frame.ResourceType)
+	exampleDomain := test_utils.AttributeValue(t, org_primary_domain.DomainVar, exampleAttributes).AsString()
+	exampleProperty := fmt.Sprintf("%s.%s", frame.UniqueResourcesID, exampleDomain)
+	updatedProperty := fmt.Sprintf("updated.%s", exampleProperty)
+	// domains are verified when they are added, if the organization doesn't require validation
+	if _, err := otherFrame.Admin.AddCustomDomainPolicy(otherFrame, &admin.AddCustomDomainPolicyRequest{OrgId: otherFrame.OrgID}); err != nil {
+		t.Fatalf("failed to add domain policy: %v", err)
+	}
+	for _, domain := range []string{exampleProperty, updatedProperty} {
+		if _, err := otherFrame.AddOrgDomain(otherFrame, &management.AddOrgDomainRequest{Domain: domain}); err != nil {
+			t.Fatalf("failed to add domain %s: %v", domain, err)
+		}
+	}
+	test_utils.RunLifecyleTest(
+		t,
+		otherFrame.BaseTestFrame,
+		[]string{otherFrame.AsOrgDefaultDependency},
+		test_utils.ReplaceAll(resourceExample, exampleDomain, ""),
+		exampleProperty, updatedProperty,
+		"", "", "",
+		false,
+		checkRemoteProperty(otherFrame),
+		helper.ZitadelGeneratedIdOnlyRegex,
+		test_utils.CheckNothing,
+		test_utils.ImportOrgId(otherFrame),
+	)
+}
+
+func checkRemoteProperty(frame *test_utils.OrgTestFrame) func(string) resource.TestCheckFunc {
+	return func(expect string) resource.TestCheckFunc {
+		return func(_ *terraform.State) error {
+			resp, err := frame.ListOrgDomains(frame, &management.ListOrgDomainsRequest{})
+			if err != nil {
+				return err
+			}
+			for _, domain := range resp.GetResult() {
+				if domain.GetIsPrimary() {
+					if domain.GetDomainName() != expect {
+						return fmt.Errorf("expected primary domain %s, but got %s", expect, domain.GetDomainName())
+					}
+					return nil
+				}
+			}
+			return fmt.Errorf("expected primary domain %s, but found none", expect)
+		}
+	}
+}
@@ -10,7 +10,7 @@ import (
 )
 
 func GetResource() *schema.Resource {
-	return &schema.Resource{
+	return helper.WithDeletionProtection(&schema.Resource{
 		Description: "Resource representing the project, which can then be granted to different organizations or users directly, containing different applications.",
 		Schema: map[string]*schema.Schema{
 			helper.OrgIDVar: helper.OrgIDResourceField,
@@ -58,5 +58,5 @@ func GetResource() *schema.Resource {
 		UpdateContext: update,
 		ReadContext:   read,
 		Importer:      helper.ImportWithIDAndOptionalOrg(ProjectIDVar),
-	}
+	}, false)
 }
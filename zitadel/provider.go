@@ -22,6 +22,7 @@ import (
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/default_domain_policy"
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/default_init_message_text"
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/default_label_policy"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/default_language"
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/default_lockout_policy"
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/default_login_policy"
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/default_login_texts"
@@ -39,21 +40,30 @@ import (
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/domain"
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/domain_claimed_message_text"
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/domain_policy"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/domain_verification"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/email_provider_http"
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/human_user"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/idp_apple"
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/idp_azure_ad"
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/idp_github"
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/idp_github_es"
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/idp_gitlab"
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/idp_gitlab_self_hosted"
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/idp_google"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/idp_jwt"
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/idp_ldap"
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/idp_oauth"
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/idp_oidc"
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/idp_saml"
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/init_message_text"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/instance_domain"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/instance_features"
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/instance_member"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/instance_name"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/instance_trusted_domain"
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/label_policy"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/label_policy_activation"
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/lockout_policy"
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/login_policy"
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/login_texts"
@@ -61,6 +71,7 @@ import (
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/machine_user"
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/notification_policy"
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/org"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/org_idp_apple"
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/org_idp_azure_ad"
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/org_idp_github"
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/org_idp_github_es"
@@ -74,6 +85,7 @@ import (
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/org_idp_saml"
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/org_member"
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/org_metadata"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/org_primary_domain"
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/password_change_message_text"
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/password_complexity_policy"
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/password_reset_message_text"
@@ -85,6 +97,9 @@ import (
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/project_grant_member"
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/project_member"
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/project_role"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/secret_generator"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/security_policy"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/sms_provider_http"
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/sms_provider_twilio"
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/smtp_config"
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/trigger_actions"
@@ -94,6 +109,7 @@ import (
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/verify_email_otp_message_text"
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/verify_phone_message_text"
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/verify_sms_otp_message_text"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/web_key"
 )
 
 var _ provider.Provider = (*providerPV6)(nil)
@@ -113,6 +129,8 @@ type providerModel struct {
 	Token          types.String `tfsdk:"token"`
 	JWTProfileFile types.String `tfsdk:"jwt_profile_file"`
 	JWTProfileJSON types.String `tfsdk:"jwt_profile_json"`
+	SystemAPIUser  types.String `tfsdk:"system_api_user"`
+	SystemAPIKey   types.String `tfsdk:"system_api_key"`
 }
 
 func (p *providerPV6) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -151,6 +169,17 @@ func (p *providerPV6) GetSchema(_ context.Context) (tfsdk.Schema, fdiag.Diagnost
 				Optional:    true,
 				Description: "Used port if not the default ports 80 or 443 are configured",
 			},
+			helper.SystemAPIUser: {
+				Type:        types.StringType,
+				Optional:    true,
+				Description: "User ID of a system API user, which is configured in the runtime configuration of ZITADEL. Only required to manage the custom domains and the name of the instance",
+			},
+			helper.SystemAPIKey: {
+				Type:        types.StringType,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "PEM encoded private key of the system API user, for example read with the file function",
+			},
 		},
 	}, nil
 }
@@ -170,6 +199,8 @@ func (p *providerPV6) Configure(ctx context.Context, req provider.ConfigureReque
 		config.JWTProfileFile.ValueString(),
 		config.JWTProfileJSON.ValueString(),
 		config.Port.ValueString(),
+		config.SystemAPIUser.ValueString(),
+		config.SystemAPIKey.ValueString(),
 	)
 	if err != nil {
 		resp.Diagnostics.AddError("failed to handle provider config", err.Error())
@@ -238,6 +269,8 @@ func Provider() *schema.Provider {
 			"zitadel_idp_saml":                   idp_saml.GetDatasource(),
 			"zitadel_idp_oauth":                  idp_oauth.GetDatasource(),
 			"zitadel_idp_oidc":                   idp_oidc.GetDatasource(),
+			"zitadel_idp_jwt":                    idp_jwt.GetDatasource(),
+			"zitadel_idp_apple":                  idp_apple.GetDatasource(),
 			"zitadel_org_jwt_idp":                org_idp_jwt.GetDatasource(),
 			"zitadel_org_oidc_idp":               org_idp_oidc.GetDatasource(),
 			"zitadel_org_idp_github":             org_idp_github.GetDatasource(),
@@ -248,8 +281,15 @@ func Provider() *schema.Provider {
 			"zitadel_org_idp_azure_ad":           org_idp_azure_ad.GetDatasource(),
 			"zitadel_org_idp_ldap":               org_idp_ldap.GetDatasource(),
 			"zitadel_org_idp_saml":               org_idp_saml.GetDatasource(),
+			"zitadel_org_idp_apple":              org_idp_apple.GetDatasource(),
 			"zitadel_org_idp_oauth":              org_idp_oauth.GetDatasource(),
 			"zitadel_default_oidc_settings":      default_oidc_settings.GetDatasource(),
+			"zitadel_default_language":           default_language.GetDatasource(),
+			"zitadel_instance_domain":            instance_domain.GetDatasource(),
+			"zitadel_instance_trusted_domain":    instance_trusted_domain.GetDatasource(),
+			"zitadel_sms_providers":              sms_provider_http.ListDatasources(),
+			"zitadel_email_providers":            email_provider_http.ListDatasources(),
+			"zitadel_domains":                    domain.ListDatasources(),
 		},
 		Schema: map[string]*schema.Schema{
 			helper.DomainVar: {
@@ -282,6 +322,17 @@ func Provider() *schema.Provider {
 				Optional:    true,
 				Description: "Used port if not the default ports 80 or 443 are configured",
 			},
+			helper.SystemAPIUser: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "User ID of a system API user, which is configured in the runtime configuration of ZITADEL. Only required to manage the custom domains and the name of the instance",
+			},
+			helper.SystemAPIKey: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "PEM encoded private key of the system API user, for example read with the file function",
+			},
 		},
 		ResourcesMap: map[string]*schema.Resource{
 			"zitadel_org":                                org.GetResource(),
@@ -290,6 +341,8 @@ func Provider() *schema.Provider {
 			"zitadel_project":                            project.GetResource(),
 			"zitadel_project_role":                       project_role.GetResource(),
 			"zitadel_domain":                             domain.GetResource(),
+			"zitadel_domain_verification":                domain_verification.GetResource(),
+			"zitadel_org_primary_domain":                 org_primary_domain.GetResource(),
 			"zitadel_action":                             action.GetResource(),
 			"zitadel_application_oidc":                   application_oidc.GetResource(),
 			"zitadel_application_api":                    application_api.GetResource(),
@@ -303,6 +356,7 @@ func Provider() *schema.Provider {
 			"zitadel_project_grant_member":               project_grant_member.GetResource(),
 			"zitadel_domain_policy":                      domain_policy.GetResource(),
 			"zitadel_label_policy":                       label_policy.GetResource(),
+			"zitadel_label_policy_activation":            label_policy_activation.GetResource(),
 			"zitadel_lockout_policy":                     lockout_policy.GetResource(),
 			"zitadel_login_policy":                       login_policy.GetResource(),
 			"zitadel_password_complexity_policy":         password_complexity_policy.GetResource(),
@@ -318,6 +372,8 @@ func Provider() *schema.Provider {
 			"zitadel_default_password_complexity_policy": default_password_complexity_policy.GetResource(),
 			"zitadel_sms_provider_twilio":                sms_provider_twilio.GetResource(),
 			"zitadel_smtp_config":                        smtp_config.GetResource(),
+			"zitadel_sms_provider_http":                  sms_provider_http.GetResource(),
+			"zitadel_email_provider_http":                email_provider_http.GetResource(),
 			"zitadel_default_notification_policy":        default_notification_policy.GetResource(),
 			"zitadel_notification_policy":                notification_policy.GetResource(),
 			"zitadel_idp_github":                         idp_github.GetResource(),
@@ -330,6 +386,8 @@ func Provider() *schema.Provider {
 			"zitadel_idp_saml":                           idp_saml.GetResource(),
 			"zitadel_idp_oauth":                          idp_oauth.GetResource(),
 			"zitadel_idp_oidc":                           idp_oidc.GetResource(),
+			"zitadel_idp_jwt":                            idp_jwt.GetResource(),
+			"zitadel_idp_apple":                          idp_apple.GetResource(),
 			"zitadel_org_idp_jwt":                        org_idp_jwt.GetResource(),
 			"zitadel_org_idp_oidc":                       org_idp_oidc.GetResource(),
 			"zitadel_org_idp_github":                     org_idp_github.GetResource(),
@@ -340,10 +398,19 @@ func Provider() *schema.Provider {
 			"zitadel_org_idp_azure_ad":                   org_idp_azure_ad.GetResource(),
 			"zitadel_org_idp_ldap":                       org_idp_ldap.GetResource(),
 			"zitadel_org_idp_saml":                       org_idp_saml.GetResource(),
+			"zitadel_org_idp_apple":                      org_idp_apple.GetResource(),
 			"zitadel_org_idp_oauth":                      org_idp_oauth.GetResource(),
 			"zitadel_default_oidc_settings":              default_oidc_settings.GetResource(),
 			"zitadel_org_metadata":                       org_metadata.GetResource(),
 			"zitadel_user_metadata":                      user_metadata.GetResource(),
+			"zitadel_default_language":                   default_language.GetResource(),
+			"zitadel_instance_trusted_domain":            instance_trusted_domain.GetResource(),
+			"zitadel_instance_domain":                    instance_domain.GetResource(),
+			"zitadel_instance_name":                      instance_name.GetResource(),
+			"zitadel_security_policy":                    security_policy.GetResource(),
+			"zitadel_secret_generator":                   secret_generator.GetResource(),
+			"zitadel_instance_features":                  instance_features.GetResource(),
+			"zitadel_web_key":                            web_key.GetResource(),
 		},
 		ConfigureContextFunc: ProviderConfigure,
 	}
@@ -357,6 +424,8 @@ func ProviderConfigure(ctx context.Context, d *schema.ResourceData) (interface{}
 		d.Get(helper.JWTProfileFile).(string),
 		d.Get(helper.JWTProfileJSON).(string),
 		d.Get(helper.PortVar).(string),
+		d.Get(helper.SystemAPIUser).(string),
+		d.Get(helper.SystemAPIKey).(string),
 	)
 	if err != nil {
 		return nil, diag.FromErr(err)
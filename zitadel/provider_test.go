@@ -0,0 +1,11 @@
+package zitadel
+
+import (
+	"testing"
+)
+
+func TestProvider(t *testing.T) {
+	if err := Provider().InternalValidate(); err != nil {
+		t.Fatalf("provider schema is invalid: %v", err)
+	}
+}
@@ -0,0 +1,11 @@
+package secret_generator
+
+const (
+	GeneratorTypeVar       = "generator_type"
+	LengthVar              = "length"
+	expiryVar              = "expiry"
+	includeLowerLettersVar = "include_lower_letters"
+	includeUpperLettersVar = "include_upper_letters"
+	includeDigitsVar       = "include_digits"
+	includeSymbolsVar      = "include_symbols"
+)
@@ -0,0 +1,103 @@
+package secret_generator
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/admin"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/settings"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
+)
+
+func delete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "secret generator cannot be deleted")
+	return nil
+}
+
+func update(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started update")
+
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return diag.Errorf("failed to get client")
+	}
+
+	client, err := helper.GetAdminClient(ctx, clientinfo)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	generatorType := d.Get(GeneratorTypeVar).(string)
+	if d.HasChanges(LengthVar, expiryVar, includeLowerLettersVar, includeUpperLettersVar, includeDigitsVar, includeSymbolsVar) {
+		expiry, err := time.ParseDuration(d.Get(expiryVar).(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		_, err = client.UpdateSecretGenerator(ctx, &admin.UpdateSecretGeneratorRequest{
+			GeneratorType:       settings.SecretGeneratorType(settings.SecretGeneratorType_value[generatorType]),
+			Length:              uint32(d.Get(LengthVar).(int)),
+			Expiry:              durationpb.New(expiry),
+			IncludeLowerLetters: d.Get(includeLowerLettersVar).(bool),
+			IncludeUpperLetters: d.Get(includeUpperLettersVar).(bool),
+			IncludeDigits:       d.Get(includeDigitsVar).(bool),
+			IncludeSymbols:      d.Get(includeSymbolsVar).(bool),
+		})
+		if helper.IgnorePreconditionError(err) != nil {
+			return diag.Errorf("failed to update secret generator: %v", err)
+		}
+	}
+	d.SetId(generatorType)
+	return nil
+}
+
+func read(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started read")
+
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return diag.Errorf("failed to get client")
+	}
+
+	client, err := helper.GetAdminClient(ctx, clientinfo)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	generatorType, ok := settings.SecretGeneratorType_value[d.Id()]
+	if !ok {
+		return diag.Errorf("unknown secret generator type %s", d.Id())
+	}
+	resp, err := client.GetSecretGenerator(ctx, &admin.GetSecretGeneratorRequest{
+		GeneratorType: settings.SecretGeneratorType(generatorType),
+	})
+	if err != nil && helper.IgnoreIfNotFoundError(err) == nil {
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.Errorf("failed to get secret generator: %v", err)
+	}
+
+	generator := resp.GetSecretGenerator()
+	set := map[string]interface{}{
+		GeneratorTypeVar:       generator.GetGeneratorType().String(),
+		LengthVar:              int(generator.GetLength()),
+		expiryVar:              generator.GetExpiry().AsDuration().String(),
+		includeLowerLettersVar: generator.GetIncludeLowerLetters(),
+		includeUpperLettersVar: generator.GetIncludeUpperLetters(),
+		includeDigitsVar:       generator.GetIncludeDigits(),
+		includeSymbolsVar:      generator.GetIncludeSymbols(),
+	}
+	for k, v := range set {
+		if err := d.Set(k, v); err != nil {
+			return diag.Errorf("failed to set %s of secret generator: %v", k, err)
+		}
+	}
+	d.SetId(generator.GetGeneratorType().String())
+	return nil
+}
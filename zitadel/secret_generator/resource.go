@@ -0,0 +1,77 @@
+package secret_generator
+
+import (
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/settings"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
+)
+
+func GetResource() *schema.Resource {
+	return &schema.Resource{
+		Description: "Resource representing the configuration of a secret generator of the instance, which generates for example the initialization codes, one time passwords and password reset codes.",
+		Schema: map[string]*schema.Schema{
+			GeneratorTypeVar: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Type of the secret generator" + helper.DescriptionEnumValuesList(generatorTypes()),
+				ValidateDiagFunc: func(value interface{}, path cty.Path) diag.Diagnostics {
+					return helper.EnumValueValidation(GeneratorTypeVar, value, helper.EnumValueMap(generatorTypes()))
+				},
+			},
+			LengthVar: {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "Length of the generated secret",
+			},
+			expiryVar: {
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "Duration after which the generated secret expires, for example \"72h0m0s\"",
+				ValidateDiagFunc: helper.DurationValidation,
+				DiffSuppressFunc: helper.SuppressEquivalentDurations,
+			},
+			includeLowerLettersVar: {
+				Type:        schema.TypeBool,
+				Required:    true,
+				Description: "Include lower case letters in the generated secret",
+			},
+			includeUpperLettersVar: {
+				Type:        schema.TypeBool,
+				Required:    true,
+				Description: "Include upper case letters in the generated secret",
+			},
+			includeDigitsVar: {
+				Type:        schema.TypeBool,
+				Required:    true,
+				Description: "Include digits in the generated secret",
+			},
+			includeSymbolsVar: {
+				Type:        schema.TypeBool,
+				Required:    true,
+				Description: "Include symbols in the generated secret",
+			},
+		},
+		DeleteContext: delete,
+		CreateContext: update,
+		UpdateContext: update,
+		ReadContext:   read,
+		Importer: helper.ImportWithAttributes(
+			helper.NewImportAttribute(GeneratorTypeVar, helper.ConvertNonEmpty, false),
+		),
+	}
+}
+
+// generatorTypes returns the configurable secret generator types without the unspecified type
+func generatorTypes() map[int32]string {
+	types := make(map[int32]string, len(settings.SecretGeneratorType_name))
+	for k, v := range settings.SecretGeneratorType_name {
+		if k != int32(settings.SecretGeneratorType_SECRET_GENERATOR_TYPE_UNSPECIFIED) {
+			types[k] = v
+		}
+	}
+	return types
+}
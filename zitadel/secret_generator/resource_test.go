@@ -0,0 +1,54 @@
+package secret_generator_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/admin"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/settings"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper/test_utils"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/secret_generator"
+)
+
+func TestAccSecretGenerator(t *testing.T) {
+	frame := test_utils.NewInstanceTestFrame(t, "zitadel_secret_generator")
+	resourceExample, exampleAttributes := test_utils.ReadExample(t, test_utils.Resources, frame.ResourceType)
+	exampleProperty := test_utils.AttributeValue(t, secret_generator.LengthVar, exampleAttributes).AsBigFloat()
+	exampleLength, _ := exampleProperty.Uint64()
+	exampleType := test_utils.AttributeValue(t, secret_generator.GeneratorTypeVar, exampleAttributes).AsString()
+	test_utils.RunLifecyleTest(
+		t,
+		frame.BaseTestFrame,
+		nil,
+		test_utils.ReplaceAll(resourceExample, exampleLength, ""),
+		exampleLength, exampleLength+2,
+		"", "", "",
+		false,
+		checkRemoteProperty(*frame, exampleType),
+		regexp.MustCompile(fmt.Sprintf("^%s$", exampleType)),
+		test_utils.CheckNothing,
+		test_utils.ImportResourceId(frame.BaseTestFrame),
+	)
+}
+
+func checkRemoteProperty(frame test_utils.InstanceTestFrame, generatorType string) func(uint64) resource.TestCheckFunc {
+	return func(expect uint64) resource.TestCheckFunc {
+		return func(state *terraform.State) error {
+			resp, err := frame.GetSecretGenerator(frame, &admin.GetSecretGeneratorRequest{
+				GeneratorType: settings.SecretGeneratorType(settings.SecretGeneratorType_value[generatorType]),
+			})
+			if err != nil {
+				return fmt.Errorf("getting secret generator failed: %w", err)
+			}
+			actual := uint64(resp.GetSecretGenerator().GetLength())
+			if actual != expect {
+				return fmt.Errorf("expected %d, but got %d", expect, actual)
+			}
+			return nil
+		}
+	}
+}
@@ -0,0 +1,7 @@
+package security_policy
+
+const (
+	EnableIframeEmbeddingVar = "enable_iframe_embedding"
+	allowedOriginsVar        = "allowed_origins"
+	enableImpersonationVar   = "enable_impersonation"
+)
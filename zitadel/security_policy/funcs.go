@@ -0,0 +1,92 @@
+package security_policy
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/admin"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
+)
+
+func delete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "security policy cannot be deleted")
+	return nil
+}
+
+func update(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started update")
+
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return diag.Errorf("failed to get client")
+	}
+
+	client, err := helper.GetAdminClient(ctx, clientinfo)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := ""
+	if d.HasChanges(EnableIframeEmbeddingVar, allowedOriginsVar, enableImpersonationVar) {
+		resp, err := client.SetSecurityPolicy(ctx, &admin.SetSecurityPolicyRequest{
+			EnableIframeEmbedding: d.Get(EnableIframeEmbeddingVar).(bool),
+			AllowedOrigins:        helper.GetOkSetToStringSlice(d, allowedOriginsVar),
+			EnableImpersonation:   d.Get(enableImpersonationVar).(bool),
+		})
+		if helper.IgnorePreconditionError(err) != nil {
+			return diag.Errorf("failed to update security policy: %v", err)
+		}
+		if resp != nil {
+			id = resp.GetDetails().GetResourceOwner()
+		}
+	}
+	if id == "" {
+		resp, err := client.GetSecurityPolicy(ctx, &admin.GetSecurityPolicyRequest{})
+		if err != nil {
+			return diag.Errorf("failed to update security policy: %v", err)
+		}
+		id = resp.GetPolicy().GetDetails().GetResourceOwner()
+	}
+	d.SetId(id)
+	return nil
+}
+
+func read(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started read")
+
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return diag.Errorf("failed to get client")
+	}
+
+	client, err := helper.GetAdminClient(ctx, clientinfo)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resp, err := client.GetSecurityPolicy(ctx, &admin.GetSecurityPolicyRequest{})
+	if err != nil && helper.IgnoreIfNotFoundError(err) == nil {
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.Errorf("failed to get security policy")
+	}
+
+	policy := resp.GetPolicy()
+	set := map[string]interface{}{
+		EnableIframeEmbeddingVar: policy.GetEnableIframeEmbedding(),
+		allowedOriginsVar:        policy.GetAllowedOrigins(),
+		enableImpersonationVar:   policy.GetEnableImpersonation(),
+	}
+	for k, v := range set {
+		if err := d.Set(k, v); err != nil {
+			return diag.Errorf("failed to set %s of security policy: %v", k, err)
+		}
+	}
+	d.SetId(policy.GetDetails().GetResourceOwner())
+	return nil
+}
@@ -0,0 +1,36 @@
+package security_policy
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
+)
+
+func GetResource() *schema.Resource {
+	return &schema.Resource{
+		Description: "Resource representing the security policy of the instance.",
+		Schema: map[string]*schema.Schema{
+			EnableIframeEmbeddingVar: {
+				Type:        schema.TypeBool,
+				Required:    true,
+				Description: "Allows ZITADEL to be loaded in an iframe by the allowed origins",
+			},
+			allowedOriginsVar: {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Origins allowed to load ZITADEL in an iframe if " + EnableIframeEmbeddingVar + " is true",
+			},
+			enableImpersonationVar: {
+				Type:        schema.TypeBool,
+				Required:    true,
+				Description: "Allows users to impersonate other users. The impersonator needs the appropriate `*_IMPERSONATOR` roles assigned as well",
+			},
+		},
+		DeleteContext: delete,
+		CreateContext: update,
+		UpdateContext: update,
+		ReadContext:   read,
+		Importer:      helper.ImportWithEmptyID(),
+	}
+}
@@ -0,0 +1,49 @@
+package security_policy_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/admin"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper/test_utils"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/security_policy"
+)
+
+func TestAccSecurityPolicy(t *testing.T) {
+	frame := test_utils.NewInstanceTestFrame(t, "zitadel_security_policy")
+	resourceExample, exampleAttributes := test_utils.ReadExample(t, test_utils.Resources, frame.ResourceType)
+	exampleProperty := test_utils.AttributeValue(t, security_policy.EnableIframeEmbeddingVar, exampleAttributes).True()
+	test_utils.RunLifecyleTest(
+		t,
+		frame.BaseTestFrame,
+		nil,
+		test_utils.ReplaceAll(resourceExample, exampleProperty, ""),
+		exampleProperty, !exampleProperty,
+		"", "", "",
+		false,
+		checkRemoteProperty(*frame),
+		helper.ZitadelGeneratedIdOnlyRegex,
+		test_utils.CheckNothing,
+		test_utils.ImportNothing,
+	)
+}
+
+func checkRemoteProperty(frame test_utils.InstanceTestFrame) func(bool) resource.TestCheckFunc {
+	return func(expect bool) resource.TestCheckFunc {
+		return func(state *terraform.State) error {
+			resp, err := frame.GetSecurityPolicy(frame, &admin.GetSecurityPolicyRequest{})
+			if err != nil {
+				return fmt.Errorf("getting policy failed: %w", err)
+			}
+			actual := resp.GetPolicy().GetEnableIframeEmbedding()
+			if actual != expect {
+				return fmt.Errorf("expected %t, but got %t", expect, actual)
+			}
+			return nil
+		}
+	}
+}
@@ -0,0 +1,16 @@
+package sms_provider_http
+
+const (
+	providerIDVar       = "provider_id"
+	EndpointVar         = "endpoint"
+	DescriptionVar      = "description"
+	SetActiveVar        = "set_active"
+	stateVar            = "state"
+	providersVar        = "providers"
+	idVar               = "id"
+	typeVar             = "type"
+	activeProviderIDVar = "active_provider_id"
+
+	typeTwilio = "twilio"
+	typeHTTP   = "http"
+)
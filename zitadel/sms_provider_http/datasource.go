@@ -0,0 +1,56 @@
+package sms_provider_http
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/settings"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
+)
+
+func ListDatasources() *schema.Resource {
+	return &schema.Resource{
+		Description: "Datasource representing all SMS providers of an instance, regardless of their type.",
+		Schema: map[string]*schema.Schema{
+			providersVar: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "All configured SMS providers",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						idVar: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the SMS provider",
+						},
+						typeVar: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Type of the SMS provider, supported values: " + typeTwilio + ", " + typeHTTP,
+						},
+						DescriptionVar: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Description of the SMS provider",
+						},
+						stateVar: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "State of the SMS provider" + helper.DescriptionEnumValuesList(settings.SMSProviderConfigState_name),
+						},
+						EndpointVar: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Endpoint the SMS are posted to, only set for the type " + typeHTTP,
+						},
+					},
+				},
+			},
+			activeProviderIDVar: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the active SMS provider, empty if no SMS provider is active",
+			},
+		},
+		ReadContext: list,
+	}
+}
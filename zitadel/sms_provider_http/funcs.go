@@ -0,0 +1,188 @@
+package sms_provider_http
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/admin"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/settings"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
+)
+
+func delete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started delete")
+
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return diag.Errorf("failed to get client")
+	}
+
+	client, err := helper.GetAdminClient(ctx, clientinfo)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = client.RemoveSMSProvider(ctx, &admin.RemoveSMSProviderRequest{Id: d.Id()})
+	if err != nil {
+		return diag.Errorf("failed to delete sms provider http: %v", err)
+	}
+	return nil
+}
+
+func create(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started create")
+
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return diag.Errorf("failed to get client")
+	}
+
+	client, err := helper.GetAdminClient(ctx, clientinfo)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resp, err := client.AddSMSProviderHTTP(ctx, &admin.AddSMSProviderHTTPRequest{
+		Endpoint:    d.Get(EndpointVar).(string),
+		Description: d.Get(DescriptionVar).(string),
+	})
+	if err != nil {
+		return diag.Errorf("failed to create sms provider http: %v", err)
+	}
+	d.SetId(resp.GetId())
+
+	if d.Get(SetActiveVar).(bool) {
+		if _, err := client.ActivateSMSProvider(ctx, &admin.ActivateSMSProviderRequest{Id: d.Id()}); err != nil {
+			return diag.Errorf("failed to activate sms provider http: %v", err)
+		}
+	}
+	return read(ctx, d, m)
+}
+
+func update(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started update")
+
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return diag.Errorf("failed to get client")
+	}
+
+	client, err := helper.GetAdminClient(ctx, clientinfo)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChanges(EndpointVar, DescriptionVar) {
+		_, err = client.UpdateSMSProviderHTTP(ctx, &admin.UpdateSMSProviderHTTPRequest{
+			Id:          d.Id(),
+			Endpoint:    d.Get(EndpointVar).(string),
+			Description: d.Get(DescriptionVar).(string),
+		})
+		if err != nil {
+			return diag.Errorf("failed to update sms provider http: %v", err)
+		}
+	}
+
+	if d.HasChange(SetActiveVar) {
+		if d.Get(SetActiveVar).(bool) {
+			_, err = client.ActivateSMSProvider(ctx, &admin.ActivateSMSProviderRequest{Id: d.Id()})
+		} else {
+			_, err = client.DeactivateSMSProvider(ctx, &admin.DeactivateSMSProviderRequest{Id: d.Id()})
+		}
+		if err != nil {
+			return diag.Errorf("failed to change activation of sms provider http: %v", err)
+		}
+	}
+	return read(ctx, d, m)
+}
+
+func read(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started read")
+
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return diag.Errorf("failed to get client")
+	}
+
+	client, err := helper.GetAdminClient(ctx, clientinfo)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resp, err := client.GetSMSProvider(ctx, &admin.GetSMSProviderRequest{
+		Id: d.Id(),
+	})
+	if err != nil && helper.IgnoreIfNotFoundError(err) == nil {
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.Errorf("failed to get sms provider http")
+	}
+
+	set := map[string]interface{}{
+		EndpointVar:    resp.GetConfig().GetHttp().GetEndpoint(),
+		DescriptionVar: resp.GetConfig().GetDescription(),
+		SetActiveVar:   resp.GetConfig().GetState() == settings.SMSProviderConfigState_SMS_PROVIDER_CONFIG_ACTIVE,
+		stateVar:       resp.GetConfig().GetState().String(),
+	}
+	for k, v := range set {
+		if err := d.Set(k, v); err != nil {
+			return diag.Errorf("failed to set %s of sms provider http: %v", k, err)
+		}
+	}
+	d.SetId(resp.GetConfig().GetId())
+	return nil
+}
+
+func list(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started list")
+
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return diag.Errorf("failed to get client")
+	}
+
+	client, err := helper.GetAdminClient(ctx, clientinfo)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resp, err := client.ListSMSProviders(ctx, &admin.ListSMSProvidersRequest{})
+	if err != nil {
+		return diag.Errorf("failed to list sms providers: %v", err)
+	}
+	providers := make([]map[string]interface{}, len(resp.GetResult()))
+	activeProviderID := ""
+	for i, provider := range resp.GetResult() {
+		providerType := typeTwilio
+		if provider.GetHttp() != nil {
+			providerType = typeHTTP
+		}
+		providers[i] = map[string]interface{}{
+			idVar:          provider.GetId(),
+			typeVar:        providerType,
+			DescriptionVar: provider.GetDescription(),
+			stateVar:       provider.GetState().String(),
+			EndpointVar:    provider.GetHttp().GetEndpoint(),
+		}
+		if provider.GetState() == settings.SMSProviderConfigState_SMS_PROVIDER_CONFIG_ACTIVE {
+			activeProviderID = provider.GetId()
+		}
+	}
+	set := map[string]interface{}{
+		providersVar:        providers,
+		activeProviderIDVar: activeProviderID,
+	}
+	for k, v := range set {
+		if err := d.Set(k, v); err != nil {
+			return diag.Errorf("failed to set %s of sms providers: %v", k, err)
+		}
+	}
+	// If the ID is blank, the datasource is deleted and not usable.
+	d.SetId("-")
+	return nil
+}
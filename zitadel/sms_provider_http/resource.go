@@ -0,0 +1,41 @@
+package sms_provider_http
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/settings"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
+)
+
+func GetResource() *schema.Resource {
+	return helper.WithDeletionProtection(&schema.Resource{
+		Description: "Resource representing the SMS provider HTTP configuration of an instance. ZITADEL posts the SMS to the configured endpoint instead of sending them.",
+		Schema: map[string]*schema.Schema{
+			EndpointVar: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Endpoint the SMS are posted to.",
+			},
+			DescriptionVar: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the SMS provider.",
+			},
+			SetActiveVar: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Activate the SMS provider, activating it deactivates all other SMS providers. If set to false, the SMS provider is deactivated.",
+			},
+			stateVar: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "State of the SMS provider" + helper.DescriptionEnumValuesList(settings.SMSProviderConfigState_name),
+			},
+		},
+		CreateContext: create,
+		DeleteContext: delete,
+		ReadContext:   read,
+		UpdateContext: update,
+		Importer:      helper.ImportWithID(providerIDVar),
+	}, false)
+}
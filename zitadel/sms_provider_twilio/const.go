@@ -1,8 +1,11 @@
 package sms_provider_twilio
 
 const (
-	providerIDVar   = "provider_id"
-	sidVar          = "sid"
-	TokenVar        = "token"
-	SenderNumberVar = "sender_number"
+	providerIDVar       = "provider_id"
+	sidVar              = "sid"
+	TokenVar            = "token"
+	SenderNumberVar     = "sender_number"
+	verifyServiceSidVar = "verify_service_sid"
+	SetActiveVar        = "set_active"
+	stateVar            = "state"
 )
@@ -45,15 +45,22 @@ func create(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Dia
 	}
 
 	resp, err := client.AddSMSProviderTwilio(ctx, &admin.AddSMSProviderTwilioRequest{
-		Sid:          d.Get(sidVar).(string),
-		Token:        d.Get(TokenVar).(string),
-		SenderNumber: d.Get(SenderNumberVar).(string),
+		Sid:              d.Get(sidVar).(string),
+		Token:            d.Get(TokenVar).(string),
+		SenderNumber:     d.Get(SenderNumberVar).(string),
+		VerifyServiceSid: d.Get(verifyServiceSidVar).(string),
 	})
 	if err != nil {
 		return diag.Errorf("failed to create sms provider twilio: %v", err)
 	}
 	d.SetId(resp.Id)
 
+	if d.Get(SetActiveVar).(bool) {
+		if _, err := client.ActivateSMSProvider(ctx, &admin.ActivateSMSProviderRequest{Id: d.Id()}); err != nil {
+			return diag.Errorf("failed to activate sms provider twilio: %v", err)
+		}
+	}
+
 	return nil
 }
 
@@ -70,11 +77,12 @@ func update(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Dia
 		return diag.FromErr(err)
 	}
 
-	if d.HasChanges(SenderNumberVar, sidVar) {
+	if d.HasChanges(SenderNumberVar, sidVar, verifyServiceSidVar) {
 		_, err = client.UpdateSMSProviderTwilio(ctx, &admin.UpdateSMSProviderTwilioRequest{
-			Id:           d.Id(),
-			Sid:          d.Get(sidVar).(string),
-			SenderNumber: d.Get(SenderNumberVar).(string),
+			Id:               d.Id(),
+			Sid:              d.Get(sidVar).(string),
+			SenderNumber:     d.Get(SenderNumberVar).(string),
+			VerifyServiceSid: d.Get(verifyServiceSidVar).(string),
 		})
 		if err != nil {
 			return diag.Errorf("failed to update sms provider twilio: %v", err)
@@ -91,6 +99,18 @@ func update(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Dia
 		}
 	}
 
+	if d.HasChange(SetActiveVar) {
+		if d.Get(SetActiveVar).(bool) {
+			if _, err := client.ActivateSMSProvider(ctx, &admin.ActivateSMSProviderRequest{Id: d.Id()}); err != nil {
+				return diag.Errorf("failed to activate sms provider twilio: %v", err)
+			}
+		} else {
+			if _, err := client.DeactivateSMSProvider(ctx, &admin.DeactivateSMSProviderRequest{Id: d.Id()}); err != nil {
+				return diag.Errorf("failed to deactivate sms provider twilio: %v", err)
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -119,8 +139,11 @@ func read(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagn
 	}
 
 	set := map[string]interface{}{
-		sidVar:          resp.GetConfig().GetTwilio().GetSid(),
-		SenderNumberVar: resp.GetConfig().GetTwilio().GetSenderNumber(),
+		sidVar:              resp.GetConfig().GetTwilio().GetSid(),
+		SenderNumberVar:     resp.GetConfig().GetTwilio().GetSenderNumber(),
+		verifyServiceSidVar: resp.GetConfig().GetTwilio().GetVerifyServiceSid(),
+		SetActiveVar:        d.Get(SetActiveVar).(bool),
+		stateVar:            resp.GetConfig().GetState().String(),
 	}
 	if token, ok := d.GetOk(TokenVar); ok {
 		set[TokenVar] = token
@@ -2,12 +2,13 @@ package sms_provider_twilio
 
 import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/settings"
 
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
 )
 
 func GetResource() *schema.Resource {
-	return &schema.Resource{
+	return helper.WithDeletionProtection(&schema.Resource{
 		Description: "Resource representing the SMS provider Twilio configuration of an instance.",
 		Schema: map[string]*schema.Schema{
 			sidVar: {
@@ -26,11 +27,26 @@ func GetResource() *schema.Resource {
 				Required:    true,
 				Description: "Sender number which is used to send the SMS.",
 			},
+			verifyServiceSidVar: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "SID of the Twilio Verify service, if set, ZITADEL uses Twilio Verify to send and check the verification codes.",
+			},
+			SetActiveVar: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Set the SMS provider active after creating/updating, activating it deactivates all other SMS providers. If changed to false, the SMS provider is deactivated.",
+			},
+			stateVar: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "State of the SMS provider" + helper.DescriptionEnumValuesList(settings.SMSProviderConfigState_name),
+			},
 		},
 		CreateContext: create,
 		DeleteContext: delete,
 		ReadContext:   read,
 		UpdateContext: update,
 		Importer:      helper.ImportWithIDAndOptionalSecret(providerIDVar, TokenVar),
-	}
+	}, false)
 }
@@ -10,4 +10,8 @@ const (
 	PasswordVar       = "password"
 	replyToAddressVar = "reply_to_address"
 	SetActiveVar      = "set_active"
+	DescriptionVar    = "description"
+	stateVar          = "state"
+	testOnApplyVar    = "test_on_apply"
+	testReceiverVar   = "test_receiver_address"
 )
@@ -2,11 +2,14 @@ package smtp_config
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	adminclient "github.com/zitadel/zitadel-go/v3/pkg/client/admin"
 	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/admin"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/settings"
 
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
 )
@@ -54,6 +57,7 @@ func create(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Dia
 		Tls:            d.Get(tlsVar).(bool),
 		Password:       d.Get(PasswordVar).(string),
 		ReplyToAddress: d.Get(replyToAddressVar).(string),
+		Description:    d.Get(DescriptionVar).(string),
 	}
 
 	resp, err := client.AddSMTPConfig(ctx, req)
@@ -62,13 +66,19 @@ func create(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Dia
 	}
 	d.SetId(resp.GetId())
 
+	if d.Get(testOnApplyVar).(bool) {
+		if err := test(ctx, client, d); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	if d.Get(SetActiveVar).(bool) {
 		if _, err := client.ActivateSMTPConfig(ctx, &admin.ActivateSMTPConfigRequest{Id: d.Id()}); err != nil {
 			return diag.Errorf("failed to activate smtp config: %v", err)
 		}
 	}
 
-	return nil
+	return read(ctx, d, m)
 }
 
 func update(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -84,7 +94,7 @@ func update(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Dia
 		return diag.FromErr(err)
 	}
 
-	if d.HasChanges(SenderAddressVar, SenderNameVar, tlsVar, hostVar, userVar, replyToAddressVar, PasswordVar) {
+	if d.HasChanges(SenderAddressVar, SenderNameVar, tlsVar, hostVar, userVar, replyToAddressVar, PasswordVar, DescriptionVar) {
 		_, err = client.UpdateSMTPConfig(ctx, &admin.UpdateSMTPConfigRequest{
 			Id:             d.Id(),
 			SenderAddress:  d.Get(SenderAddressVar).(string),
@@ -94,18 +104,43 @@ func update(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Dia
 			User:           d.Get(userVar).(string),
 			ReplyToAddress: d.Get(replyToAddressVar).(string),
 			Password:       d.Get(PasswordVar).(string),
+			Description:    d.Get(DescriptionVar).(string),
 		})
 		if err != nil {
 			return diag.Errorf("failed to update smtp config: %v", err)
 		}
 	}
 
-	if d.HasChange(SetActiveVar) && d.Get(SetActiveVar).(bool) {
-		if _, err := client.ActivateSMTPConfig(ctx, &admin.ActivateSMTPConfigRequest{Id: d.Id()}); err != nil {
-			return diag.Errorf("failed to activate smtp config: %v", err)
+	if d.Get(testOnApplyVar).(bool) && d.HasChanges(SenderAddressVar, SenderNameVar, tlsVar, hostVar, userVar, PasswordVar, testOnApplyVar, testReceiverVar) {
+		if err := test(ctx, client, d); err != nil {
+			return diag.FromErr(err)
 		}
 	}
 
+	if d.HasChange(SetActiveVar) {
+		if d.Get(SetActiveVar).(bool) {
+			if _, err := client.ActivateSMTPConfig(ctx, &admin.ActivateSMTPConfigRequest{Id: d.Id()}); err != nil {
+				return diag.Errorf("failed to activate smtp config: %v", err)
+			}
+		} else {
+			if _, err := client.DeactivateSMTPConfig(ctx, &admin.DeactivateSMTPConfigRequest{Id: d.Id()}); err != nil {
+				return diag.Errorf("failed to deactivate smtp config: %v", err)
+			}
+		}
+	}
+
+	return read(ctx, d, m)
+}
+
+// test sends a test email with the stored SMTP configuration, so that invalid credentials fail the apply
+func test(ctx context.Context, client *adminclient.Client, d *schema.ResourceData) error {
+	receiver := d.Get(testReceiverVar).(string)
+	if _, err := client.TestSMTPConfigById(ctx, &admin.TestSMTPConfigByIdRequest{
+		Id:              d.Id(),
+		ReceiverAddress: receiver,
+	}); err != nil {
+		return fmt.Errorf("failed to send test email with smtp config %s to %s: %w", d.Id(), receiver, err)
+	}
 	return nil
 }
 
@@ -141,7 +176,9 @@ func read(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagn
 		userVar:           resp.GetSmtpConfig().GetUser(),
 		PasswordVar:       d.Get(PasswordVar).(string),
 		replyToAddressVar: resp.GetSmtpConfig().GetReplyToAddress(),
-		SetActiveVar:      d.Get(SetActiveVar).(bool),
+		SetActiveVar:      resp.GetSmtpConfig().GetState() == settings.SMTPConfigState_SMTP_CONFIG_ACTIVE,
+		DescriptionVar:    resp.GetSmtpConfig().GetDescription(),
+		stateVar:          resp.GetSmtpConfig().GetState().String(),
 	}
 	for k, v := range set {
 		if err := d.Set(k, v); err != nil {
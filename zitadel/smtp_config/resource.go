@@ -2,12 +2,13 @@ package smtp_config
 
 import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/settings"
 
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
 )
 
 func GetResource() *schema.Resource {
-	return &schema.Resource{
+	return helper.WithDeletionProtection(&schema.Resource{
 		Description: "Resource representing the SMTP configuration of an instance.",
 		Schema: map[string]*schema.Schema{
 			SenderAddressVar: {
@@ -49,7 +50,28 @@ func GetResource() *schema.Resource {
 			SetActiveVar: {
 				Type:        schema.TypeBool,
 				Optional:    true,
-				Description: "Set the SMTP configuration active after creating/updating",
+				Description: "Set the SMTP configuration active after creating/updating, activating it deactivates all other SMTP configurations. If changed to false, the SMTP configuration is deactivated.",
+			},
+			DescriptionVar: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the SMTP configuration.",
+			},
+			stateVar: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "State of the SMTP configuration" + helper.DescriptionEnumValuesList(settings.SMTPConfigState_name),
+			},
+			testOnApplyVar: {
+				Type:         schema.TypeBool,
+				Optional:     true,
+				Description:  "Send a test email to the test_receiver_address after creating/updating, the apply fails if ZITADEL can't send it.",
+				RequiredWith: []string{testReceiverVar},
+			},
+			testReceiverVar: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Address the test email is sent to if test_on_apply is true.",
 			},
 		},
 		CreateContext: create,
@@ -57,5 +79,5 @@ func GetResource() *schema.Resource {
 		ReadContext:   read,
 		UpdateContext: update,
 		Importer:      helper.ImportWithIDAndOptionalSecret(IDVar, PasswordVar),
-	}
+	}, false)
 }
@@ -0,0 +1,26 @@
+package web_key
+
+const (
+	WebKeyIDVar   = "id"
+	typeVar       = "type"
+	rsaBitsVar    = "rsa_bits"
+	rsaHasherVar  = "rsa_hasher"
+	ecdsaCurveVar = "ecdsa_curve"
+	ActiveVar     = "active"
+	stateVar      = "state"
+	keepersVar    = "keepers"
+
+	typeRSA     = "RSA"
+	typeECDSA   = "ECDSA"
+	typeED25519 = "ED25519"
+
+	webKeysEndpoint = "/v2beta/web_keys"
+	stateActive     = "STATE_ACTIVE"
+)
+
+var (
+	types      = []string{typeRSA, typeECDSA, typeED25519}
+	rsaBits    = []string{"RSA_BITS_2048", "RSA_BITS_3072", "RSA_BITS_4096"}
+	rsaHashers = []string{"RSA_HASHER_SHA256", "RSA_HASHER_SHA384", "RSA_HASHER_SHA512"}
+	curves     = []string{"ECDSA_CURVE_P256", "ECDSA_CURVE_P384", "ECDSA_CURVE_P512"}
+)
@@ -0,0 +1,166 @@
+package web_key
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
+)
+
+type webKey struct {
+	Details struct {
+		ID string `json:"id"`
+	} `json:"details"`
+	State  string `json:"state"`
+	Config struct {
+		RSA *struct {
+			Bits   string `json:"bits"`
+			Hasher string `json:"hasher"`
+		} `json:"rsa,omitempty"`
+		ECDSA *struct {
+			Curve string `json:"curve"`
+		} `json:"ecdsa,omitempty"`
+		ED25519 *struct{} `json:"ed25519,omitempty"`
+	} `json:"config"`
+}
+
+func delete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started delete")
+
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return diag.Errorf("failed to get client")
+	}
+
+	err := helper.RESTCall(ctx, clientinfo, http.MethodDelete, webKeysEndpoint+"/"+d.Id(), nil, nil)
+	if err != nil && !errors.Is(err, helper.ErrRESTNotFound) {
+		return diag.Errorf("failed to delete web key: %v", err)
+	}
+	return nil
+}
+
+func create(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started create")
+
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return diag.Errorf("failed to get client")
+	}
+
+	resp := struct {
+		Details struct {
+			ID string `json:"id"`
+		} `json:"details"`
+	}{}
+	err := helper.RESTCall(ctx, clientinfo, http.MethodPost, webKeysEndpoint, generateRequest(
+		d.Get(typeVar).(string),
+		d.Get(rsaBitsVar).(string),
+		d.Get(rsaHasherVar).(string),
+		d.Get(ecdsaCurveVar).(string),
+	), &resp)
+	if err != nil {
+		return diag.Errorf("failed to create web key: %v", err)
+	}
+	d.SetId(resp.Details.ID)
+	if d.Get(ActiveVar).(bool) {
+		if err := activate(ctx, clientinfo, d.Id()); err != nil {
+			return diag.Errorf("failed to activate web key: %v", err)
+		}
+	}
+	return read(ctx, d, m)
+}
+
+func update(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started update")
+
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return diag.Errorf("failed to get client")
+	}
+
+	if d.HasChange(ActiveVar) {
+		// a key is deactivated by activating another one, which is either done already or follows in the same apply
+		if !d.Get(ActiveVar).(bool) {
+			tflog.Info(ctx, "web key stays active until another web key is activated", map[string]interface{}{"id": d.Id()})
+			return read(ctx, d, m)
+		}
+		if err := activate(ctx, clientinfo, d.Id()); err != nil {
+			return diag.Errorf("failed to activate web key: %v", err)
+		}
+	}
+	return read(ctx, d, m)
+}
+
+func read(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started read")
+
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return diag.Errorf("failed to get client")
+	}
+
+	resp := struct {
+		WebKeys []*webKey `json:"webKeys"`
+	}{}
+	if err := helper.RESTCall(ctx, clientinfo, http.MethodGet, webKeysEndpoint, nil, &resp); err != nil {
+		return diag.Errorf("failed to list web keys: %v", err)
+	}
+	var key *webKey
+	for _, k := range resp.WebKeys {
+		if k.Details.ID == d.Id() {
+			key = k
+			break
+		}
+	}
+	if key == nil {
+		d.SetId("")
+		return nil
+	}
+	set := map[string]interface{}{
+		ActiveVar: key.State == stateActive,
+		stateVar:  key.State,
+	}
+	switch {
+	case key.Config.RSA != nil:
+		set[typeVar] = typeRSA
+		set[rsaBitsVar] = key.Config.RSA.Bits
+		set[rsaHasherVar] = key.Config.RSA.Hasher
+	case key.Config.ECDSA != nil:
+		set[typeVar] = typeECDSA
+		set[ecdsaCurveVar] = key.Config.ECDSA.Curve
+	case key.Config.ED25519 != nil:
+		set[typeVar] = typeED25519
+	}
+	for k, v := range set {
+		if err := d.Set(k, v); err != nil {
+			return diag.Errorf("failed to set %s of web key: %v", k, err)
+		}
+	}
+	return nil
+}
+
+func activate(ctx context.Context, clientinfo *helper.ClientInfo, id string) error {
+	return helper.RESTCall(ctx, clientinfo, http.MethodPost, webKeysEndpoint+"/"+id+"/_activate", nil, nil)
+}
+
+// generateRequest returns the request body for the key type, the parameters of the other types are ignored
+// and unset parameters of the key type are defaulted
+func generateRequest(keyType, bits, hasher, curve string) map[string]interface{} {
+	bits = cmp.Or(bits, rsaBits[0])
+	hasher = cmp.Or(hasher, rsaHashers[0])
+	curve = cmp.Or(curve, curves[0])
+	switch keyType {
+	case typeRSA:
+		return map[string]interface{}{"rsa": map[string]string{"bits": bits, "hasher": hasher}}
+	case typeECDSA:
+		return map[string]interface{}{"ecdsa": map[string]string{"curve": curve}}
+	default:
+		return map[string]interface{}{"ed25519": map[string]string{}}
+	}
+}
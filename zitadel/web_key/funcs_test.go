@@ -0,0 +1,51 @@
+package web_key
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestGenerateRequest(t *testing.T) {
+	tests := []struct {
+		name    string
+		keyType string
+		params  []string
+		expect  string
+	}{{
+		name:    "rsa",
+		keyType: typeRSA,
+		params:  []string{"RSA_BITS_3072", "RSA_HASHER_SHA512", "ECDSA_CURVE_P384"},
+		expect:  `{"rsa":{"bits":"RSA_BITS_3072","hasher":"RSA_HASHER_SHA512"}}`,
+	}, {
+		name:    "rsa defaults",
+		keyType: typeRSA,
+		params:  []string{"", "", ""},
+		expect:  `{"rsa":{"bits":"RSA_BITS_2048","hasher":"RSA_HASHER_SHA256"}}`,
+	}, {
+		name:    "ecdsa",
+		keyType: typeECDSA,
+		params:  []string{"RSA_BITS_3072", "RSA_HASHER_SHA512", "ECDSA_CURVE_P384"},
+		expect:  `{"ecdsa":{"curve":"ECDSA_CURVE_P384"}}`,
+	}, {
+		name:    "ecdsa defaults",
+		keyType: typeECDSA,
+		params:  []string{"", "", ""},
+		expect:  `{"ecdsa":{"curve":"ECDSA_CURVE_P256"}}`,
+	}, {
+		name:    "ed25519",
+		keyType: typeED25519,
+		params:  []string{"RSA_BITS_3072", "RSA_HASHER_SHA512", "ECDSA_CURVE_P384"},
+		expect:  `{"ed25519":{}}`,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body, err := json.Marshal(generateRequest(tt.keyType, tt.params[0], tt.params[1], tt.params[2]))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(body) != tt.expect {
+				t.Errorf("generateRequest() = %s, want %s", body, tt.expect)
+			}
+		})
+	}
+}
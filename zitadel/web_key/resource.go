@@ -0,0 +1,80 @@
+package web_key
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
+)
+
+func GetResource() *schema.Resource {
+	return &schema.Resource{
+		Description: "Resource representing a web key of the instance, which is used to sign the tokens. " +
+			"Web keys require the web_key feature of the instance, see zitadel_instance_features. " +
+			"To rotate the keys on a schedule, change the keepers, for example with a time_rotating resource, and use the create_before_destroy lifecycle, so the new key is activated before the old one is deleted.",
+		Schema: map[string]*schema.Schema{
+			typeVar: {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				Description:      "Type of the generated key pair" + helper.DescriptionEnumValuesList(stringsToEnum(types)),
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(types, false)),
+			},
+			rsaBitsVar: {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				Description:      "Bit size of RSA keys, defaults to " + rsaBits[0] + helper.DescriptionEnumValuesList(stringsToEnum(rsaBits)),
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(rsaBits, false)),
+			},
+			rsaHasherVar: {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				Description:      "Hash algorithm used with RSA keys, defaults to " + rsaHashers[0] + helper.DescriptionEnumValuesList(stringsToEnum(rsaHashers)),
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(rsaHashers, false)),
+			},
+			ecdsaCurveVar: {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				Description:      "Curve of ECDSA keys, defaults to " + curves[0] + helper.DescriptionEnumValuesList(stringsToEnum(curves)),
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(curves, false)),
+			},
+			ActiveVar: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Activate the key, so it is used to sign new tokens. The previously active key is deactivated. An active key can only be deactivated by activating another key",
+			},
+			stateVar: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "State of the key",
+			},
+			keepersVar: {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary values that, when changed, generate a new key",
+			},
+		},
+		CreateContext: create,
+		UpdateContext: update,
+		DeleteContext: delete,
+		ReadContext:   read,
+		Importer:      helper.ImportWithID(WebKeyIDVar),
+	}
+}
+
+func stringsToEnum(values []string) map[int32]string {
+	enum := make(map[int32]string, len(values))
+	for i, v := range values {
+		enum[int32(i)] = v
+	}
+	return enum
+}
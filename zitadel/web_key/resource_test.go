@@ -0,0 +1,151 @@
+package web_key_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper/test_utils"
+)
+
+func TestAccWebKey(t *testing.T) {
+	frame := test_utils.NewInstanceTestFrame(t, "zitadel_web_key")
+	resourceExample, exampleAttributes := test_utils.ReadExample(t, test_utils.Resources, frame.ResourceType)
+	exampleProperty := test_utils.AttributeValue(t, "rsa_bits", exampleAttributes).AsString()
+	if err := helper.RESTCall(frame, frame.ClientInfo, http.MethodPut, "/v2beta/features/instance", map[string]bool{"webKey": true}, nil); err != nil {
+		t.Fatalf("failed to enable the web key feature: %v", err)
+	}
+	test_utils.RunLifecyleTest(
+		t,
+		frame.BaseTestFrame,
+		nil,
+		test_utils.ReplaceAll(resourceExample, exampleProperty, ""),
+		exampleProperty, "RSA_BITS_3072",
+		"", "", "",
+		false,
+		checkRemoteProperty(*frame),
+		helper.ZitadelGeneratedIdOnlyRegex,
+		test_utils.CheckNothing,
+		test_utils.ImportResourceId(frame.BaseTestFrame),
+		"keepers",
+	)
+}
+
+func TestAccWebKeyECDSAImport(t *testing.T) {
+	frame := test_utils.NewInstanceTestFrame(t, "zitadel_web_key")
+	if err := helper.RESTCall(frame, frame.ClientInfo, http.MethodPut, "/v2beta/features/instance", map[string]bool{"webKey": true}, nil); err != nil {
+		t.Fatalf("failed to enable the web key feature: %v", err)
+	}
+	config := fmt.Sprintf(`%s
+resource "zitadel_web_key" "default" {
+  type = "ECDSA"
+}`, frame.ProviderSnippet)
+	resource.ParallelTest(t, resource.TestCase{
+		Steps: []resource.TestStep{{ // The parameters are read from the created key
+			Config: config,
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr(frame.TerraformName, "ecdsa_curve", "ECDSA_CURVE_P256"),
+				resource.TestCheckResourceAttr(frame.TerraformName, "rsa_bits", ""),
+				resource.TestCheckResourceAttr(frame.TerraformName, "rsa_hasher", ""),
+			),
+		}, {
+			ResourceName:            frame.TerraformName,
+			ImportState:             true,
+			ImportStateVerify:       true,
+			ImportStatePersist:      true,
+			ImportStateVerifyIgnore: []string{"keepers"},
+		}, { // The imported key isn't replaced, as the RSA parameters aren't defaulted
+			Config:   config,
+			PlanOnly: true,
+		}},
+		ProtoV6ProviderFactories: frame.ProtoV6ProviderFactories(),
+	})
+}
+
+func TestAccWebKeyRotation(t *testing.T) {
+	frame := test_utils.NewInstanceTestFrame(t, "zitadel_web_key")
+	if err := helper.RESTCall(frame, frame.ClientInfo, http.MethodPut, "/v2beta/features/instance", map[string]bool{"webKey": true}, nil); err != nil {
+		t.Fatalf("failed to enable the web key feature: %v", err)
+	}
+	config := func(activeKey string) string {
+		return fmt.Sprintf(`%s
+resource "zitadel_web_key" "default" {
+  type   = "ED25519"
+  active = %t
+}
+
+resource "zitadel_web_key" "next" {
+  type   = "ED25519"
+  active = %t
+}`, frame.ProviderSnippet, activeKey == "default", activeKey == "next")
+	}
+	resource.Test(t, resource.TestCase{
+		Steps: []resource.TestStep{{
+			Config: config("default"),
+			Check:  checkRemoteActive(*frame, frame.TerraformName),
+		}, { // Activating the next key deactivates the current one
+			Config: config("next"),
+			Check:  checkRemoteActive(*frame, "zitadel_web_key.next"),
+		}, { // The deactivated key has no diff after the rotation
+			Config:   config("next"),
+			PlanOnly: true,
+		}},
+		ProtoV6ProviderFactories: frame.ProtoV6ProviderFactories(),
+	})
+}
+
+// checkRemoteActive checks that the web key of the given resource is the active one
+func checkRemoteActive(frame test_utils.InstanceTestFrame, terraformName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		resp := struct {
+			WebKeys []struct {
+				Details struct {
+					ID string `json:"id"`
+				} `json:"details"`
+				State string `json:"state"`
+			} `json:"webKeys"`
+		}{}
+		if err := helper.RESTCall(frame, frame.ClientInfo, http.MethodGet, "/v2beta/web_keys", nil, &resp); err != nil {
+			return fmt.Errorf("listing web keys failed: %w", err)
+		}
+		id := state.RootModule().Resources[terraformName].Primary.ID
+		for _, key := range resp.WebKeys {
+			if key.State == "STATE_ACTIVE" {
+				if key.Details.ID != id {
+					return fmt.Errorf("expected web key %s to be active, but %s is", id, key.Details.ID)
+				}
+				return nil
+			}
+		}
+		return fmt.Errorf("expected web key %s to be active, but no key is", id)
+	}
+}
+
+func checkRemoteProperty(frame test_utils.InstanceTestFrame) func(string) resource.TestCheckFunc {
+	return func(expect string) resource.TestCheckFunc {
+		return func(state *terraform.State) error {
+			resp := struct {
+				WebKeys []struct {
+					Config struct {
+						RSA struct {
+							Bits string `json:"bits"`
+						} `json:"rsa"`
+					} `json:"config"`
+				} `json:"webKeys"`
+			}{}
+			if err := helper.RESTCall(frame, frame.ClientInfo, http.MethodGet, "/v2beta/web_keys", nil, &resp); err != nil {
+				return fmt.Errorf("listing web keys failed: %w", err)
+			}
+			for _, key := range resp.WebKeys {
+				if key.Config.RSA.Bits == expect {
+					return nil
+				}
+			}
+			return fmt.Errorf("expected a web key with %s, but didn't find one", expect)
+		}
+	}
+}